@@ -26,6 +26,7 @@ func (e *AppError) Unwrap() error {
 
 var (
 	Unauthorized                      = New(http.StatusUnauthorized, ErrUnauthorized)
+	InvalidRefreshToken               = New(http.StatusUnauthorized, ErrInvalidRefreshToken)
 	InvalidCredential                 = New(http.StatusBadRequest, ErrInvalidCredential)
 	InvalidToken                      = New(http.StatusBadRequest, ErrInvalidToken)
 	InvalidEmail                      = New(http.StatusBadRequest, ErrInvalidEmail)
@@ -57,6 +58,32 @@ var (
 	InvalidShipmentMethods            = New(http.StatusBadRequest, ErrShipmentMethodInvalid)
 	TelemedicineHasBeenEnded          = New(http.StatusBadRequest, ErrTelemedicineHasBeenEnded)
 	TelemedicineOngoingWithDoctor     = New(http.StatusBadRequest, ErrTelemedicineOngoingWithDoctor)
+	TelemedicineNotPaid               = New(http.StatusBadRequest, ErrTelemedicineNotPaid)
+	TelemedicineNotStarted            = New(http.StatusBadRequest, ErrTelemedicineNotStarted)
+	TelemedicineQueued                = New(http.StatusBadRequest, ErrTelemedicineQueued)
+	SlotUnavailable                   = New(http.StatusBadRequest, ErrSlotUnavailable)
+	ScheduleOverlap                   = New(http.StatusBadRequest, ErrScheduleOverlap)
+	InvalidScheduleTime               = New(http.StatusBadRequest, ErrInvalidScheduleTime)
+	AppointmentCannotChange           = New(http.StatusBadRequest, ErrAppointmentCannotChange)
+	TelemedicineNotEnded              = New(http.StatusBadRequest, ErrTelemedicineNotEnded)
+	ReviewAlreadyExists               = New(http.StatusBadRequest, ErrReviewAlreadyExists)
+	PrescriptionNotExist              = New(http.StatusBadRequest, ErrPrescriptionNotExist)
+	PrescriptionRedeemed              = New(http.StatusBadRequest, ErrPrescriptionRedeemed)
+	PrescriptionDrugUnavailable       = New(http.StatusBadRequest, ErrPrescriptionDrugUnavailable)
+	PrescriptionRequired              = New(http.StatusBadRequest, ErrPrescriptionRequired)
+	PrescriptionExpired               = New(http.StatusBadRequest, ErrPrescriptionExpired)
+	ICD10CodeNotFound                 = New(http.StatusBadRequest, ErrICD10CodeNotFound)
+	DuplicateDiagnosis                = New(http.StatusBadRequest, ErrDuplicateDiagnosis)
+	InvalidPrimaryDiagnosis           = New(http.StatusBadRequest, ErrInvalidPrimaryDiagnosis)
+	PatientRecordUnavailable          = New(http.StatusBadRequest, ErrPatientRecordUnavailable)
+	SevereDrugInteraction             = New(http.StatusBadRequest, ErrSevereDrugInteraction)
+	DependentNotExist                 = New(http.StatusBadRequest, ErrDependentNotExist)
+	InvalidDateOfBirth                = New(http.StatusBadRequest, ErrInvalidDateOfBirth)
+	CantDisputeOrder                  = New(http.StatusBadRequest, ErrCantDisputeOrder)
+	InvalidOrderTransition            = New(http.StatusBadRequest, ErrInvalidOrderTransition)
+	StockBelowReserved                = New(http.StatusBadRequest, ErrStockBelowReserved)
+	ReleaseExceedsReserved            = New(http.StatusBadRequest, ErrReleaseExceedsReserved)
+	CantResolveDispute                = New(http.StatusBadRequest, ErrCantResolveDispute)
 	CategoryNameExist                 = New(http.StatusBadRequest, ErrCategoryNameExist)
 	CantCancelOrder                   = New(http.StatusBadRequest, ErrCantCancelOrder)
 	CantRequestToSamePharmacy         = New(http.StatusBadRequest, ErrCantRequestToSamePharmacy)
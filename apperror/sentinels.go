@@ -11,6 +11,7 @@ var (
 	ErrInvalidCredential                 = errors.New("email or password is incorrect")
 	ErrInvalidEmail                      = errors.New("email is invalid")
 	ErrInvalidToken                      = errors.New("token is invalid")
+	ErrInvalidRefreshToken               = errors.New("refresh token is invalid or expired")
 	ErrInvalidPassword                   = errors.New("password is incorrect")
 	ErrInvalidPassToken                  = errors.New("password or token combination is incorrect")
 	ErrInvalidParam                      = errors.New("route param is invalid")
@@ -40,6 +41,32 @@ var (
 	ErrShipmentMethodInvalid             = errors.New("shipment methods doesn't appear on pharmacy")
 	ErrTelemedicineHasBeenEnded          = errors.New("telemedicine has been ended")
 	ErrTelemedicineOngoingWithDoctor     = errors.New("still doing telemedicine with this doctor")
+	ErrTelemedicineNotPaid               = errors.New("telemedicine payment has not been confirmed")
+	ErrTelemedicineNotStarted            = errors.New("telemedicine has not started yet")
+	ErrTelemedicineQueued                = errors.New("telemedicine is still waiting in the queue")
+	ErrSlotUnavailable                   = errors.New("the selected slot is not available")
+	ErrScheduleOverlap                   = errors.New("the schedule overlaps with another schedule")
+	ErrInvalidScheduleTime               = errors.New("start time should be before end time")
+	ErrAppointmentCannotChange           = errors.New("the appointment can no longer be changed")
+	ErrTelemedicineNotEnded              = errors.New("telemedicine has not been ended")
+	ErrReviewAlreadyExists               = errors.New("telemedicine has already been reviewed")
+	ErrPrescriptionNotExist              = errors.New("telemedicine has no prescription")
+	ErrPrescriptionRedeemed              = errors.New("prescription has already been redeemed")
+	ErrPrescriptionDrugUnavailable       = errors.New("no nearby pharmacy has enough stock for the prescribed drug")
+	ErrPrescriptionRequired              = errors.New("this drug can only be bought with a valid prescription")
+	ErrPrescriptionExpired               = errors.New("prescription has expired")
+	ErrICD10CodeNotFound                 = errors.New("icd-10 code is not found")
+	ErrDuplicateDiagnosis                = errors.New("diagnosis code must be unique")
+	ErrInvalidPrimaryDiagnosis           = errors.New("exactly one diagnosis must be primary")
+	ErrPatientRecordUnavailable          = errors.New("patient record is only available during an active session with the patient")
+	ErrSevereDrugInteraction             = errors.New("drug combination has a severe interaction")
+	ErrDependentNotExist                 = errors.New("the dependent is not exist")
+	ErrInvalidDateOfBirth                = errors.New("date of birth cannot be in the future")
+	ErrCantDisputeOrder                  = errors.New("order can only be disputed while it is sent and before the confirmation window closes")
+	ErrInvalidOrderTransition            = errors.New("order status transition is not allowed")
+	ErrStockBelowReserved                = errors.New("stock cannot be lower than the quantity reserved by pending orders")
+	ErrReleaseExceedsReserved            = errors.New("cannot release more stock than is reserved")
+	ErrCantResolveDispute                = errors.New("order can only be resolved while it is disputed")
 	ErrCategoryNameExist                 = errors.New("the category name is exist")
 	ErrCantCancelOrder                   = errors.New("cannot cancel the order")
 	ErrCantRequestToSamePharmacy         = errors.New("cannot request to the same pharmacy")
@@ -0,0 +1,23 @@
+package config
+
+type DocumentEnv struct {
+	SigningKey string
+	VerifyURL  string
+}
+
+func (e *DocumentEnv) loadEnv() error {
+	signingKey, err := getEnv("DOCUMENT_SIGNING_KEY")
+	if err != nil {
+		return err
+	}
+
+	verifyURL, err := getEnv("DOCUMENT_VERIFY_URL")
+	if err != nil {
+		return err
+	}
+
+	e.SigningKey = signingKey
+	e.VerifyURL = verifyURL
+
+	return nil
+}
@@ -17,6 +17,9 @@ var FE = new(FeEnv)
 var SMTP = new(SmtpEnv)
 var Cloudinary = new(UploadCloudinaryEnv)
 var RajaOngkir = new(RajaOngkirEnv)
+var Telemedicine = new(TelemedicineEnv)
+var Worker = new(WorkerEnv)
+var Document = new(DocumentEnv)
 
 func Load() {
 	if err := godotenv.Load(); err != nil {
@@ -54,6 +57,18 @@ func Load() {
 	if err := RajaOngkir.loadEnv(); err != nil {
 		logrus.Fatal(err)
 	}
+
+	if err := Telemedicine.loadEnv(); err != nil {
+		logrus.Fatal(err)
+	}
+
+	if err := Worker.loadEnv(); err != nil {
+		logrus.Fatal(err)
+	}
+
+	if err := Document.loadEnv(); err != nil {
+		logrus.Fatal(err)
+	}
 }
 
 func getEnv(key string) (string, error) {
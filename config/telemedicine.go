@@ -0,0 +1,22 @@
+package config
+
+import "fmt"
+
+type TelemedicineEnv struct {
+	MaxConcurrent int
+}
+
+func (e *TelemedicineEnv) loadEnv() error {
+	maxConcurrent, err := getIntEnv("TELEMEDICINE_MAX_CONCURRENT")
+	if err != nil {
+		return err
+	}
+
+	if maxConcurrent < 1 {
+		return fmt.Errorf("%s env is must be at least 1", "TELEMEDICINE_MAX_CONCURRENT")
+	}
+
+	e.MaxConcurrent = maxConcurrent
+
+	return nil
+}
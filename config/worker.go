@@ -0,0 +1,39 @@
+package config
+
+import "time"
+
+type WorkerEnv struct {
+	Interval           time.Duration
+	SessionIdleTimeout time.Duration
+	DoctorIdleTimeout  time.Duration
+	OrderAutoConfirm   time.Duration
+}
+
+func (e *WorkerEnv) loadEnv() error {
+	interval, err := getIntEnv("WORKER_INTERVAL_SECONDS")
+	if err != nil {
+		return err
+	}
+
+	sessionIdle, err := getIntEnv("WORKER_SESSION_IDLE_MINUTES")
+	if err != nil {
+		return err
+	}
+
+	doctorIdle, err := getIntEnv("WORKER_DOCTOR_IDLE_MINUTES")
+	if err != nil {
+		return err
+	}
+
+	orderAutoConfirm, err := getIntEnv("WORKER_ORDER_AUTO_CONFIRM_HOURS")
+	if err != nil {
+		return err
+	}
+
+	e.Interval = time.Duration(interval) * time.Second
+	e.SessionIdleTimeout = time.Duration(sessionIdle) * time.Minute
+	e.DoctorIdleTimeout = time.Duration(doctorIdle) * time.Minute
+	e.OrderAutoConfirm = time.Duration(orderAutoConfirm) * time.Hour
+
+	return nil
+}
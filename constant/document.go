@@ -0,0 +1,13 @@
+package constant
+
+const (
+	DocumentCodeLength = 16
+
+	DocumentCertificate  = "certificate"
+	DocumentPrescription = "prescription"
+
+	DocumentValid            = "valid"
+	DocumentExpired          = "expired"
+	DocumentSuperseded       = "superseded"
+	DocumentInvalidSignature = "invalid signature"
+)
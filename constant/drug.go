@@ -2,4 +2,6 @@ package constant
 
 const (
 	MaxBoughtData = 5
+
+	HardDrugClassification = "obat keras"
 )
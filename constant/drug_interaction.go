@@ -0,0 +1,7 @@
+package constant
+
+const (
+	InteractionMinor    = "minor"
+	InteractionModerate = "moderate"
+	InteractionSevere   = "severe"
+)
@@ -0,0 +1,15 @@
+package constant
+
+import "time"
+
+const (
+	EventMessage              = "message"
+	EventTelemedicineEnded    = "telemedicine_ended"
+	EventPrescriptionReady    = "prescription_ready"
+	EventCertificateReady     = "certificate_ready"
+	EventTelemedicineAdmitted = "telemedicine_admitted"
+	EventPing                 = "ping"
+
+	HubBufferSize   = 16
+	StreamKeepAlive = 30 * time.Second
+)
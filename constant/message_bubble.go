@@ -0,0 +1,12 @@
+package constant
+
+const (
+	PayloadText     = "text"
+	PayloadImage    = "image"
+	PayloadDocument = "document"
+)
+
+var AttachmentPayloadType = map[string]string{
+	Image: PayloadImage,
+	PDF:   PayloadDocument,
+}
@@ -0,0 +1,6 @@
+package constant
+
+const (
+	PaymentExpiredReason     = "payment was not completed before it expired"
+	OrderAutoConfirmedReason = "order was confirmed automatically after the confirmation window closed"
+)
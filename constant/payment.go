@@ -0,0 +1,8 @@
+package constant
+
+const (
+	PaymentExpiryLockKey = 1002
+
+	JobExpireUnpaidPayment = "expire_unpaid_payment"
+	JobAutoConfirmOrder    = "auto_confirm_order"
+)
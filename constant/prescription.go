@@ -0,0 +1,10 @@
+package constant
+
+const (
+	PrescriptionValidDays = 30
+
+	PrescriptionUnfilled        = "unfilled"
+	PrescriptionPartiallyFilled = "partially filled"
+	PrescriptionFilled          = "filled"
+	PrescriptionExpired         = "expired"
+)
@@ -0,0 +1,9 @@
+package constant
+
+const (
+	AppointmentBooked = "booked"
+
+	ExclusionViolationCode  = "23P01"
+	UniqueViolationCode     = "23505"
+	ForeignKeyViolationCode = "23503"
+)
@@ -5,6 +5,9 @@ import "time"
 const (
 	JwtIssuer          = "jwtSeahat"
 	JwtDefaultDuration = 1 * time.Hour
+
+	RefreshTokenDuration = 7 * 24 * time.Hour
+	RefreshTokenLength   = 64
 )
 
 const (
@@ -19,7 +19,10 @@ const (
 	Doctor  = "doctor"
 	Manager = "manager"
 	Admin   = "admin"
+	System  = "system"
 
 	LengthOfRequestID = 15
 	MetreToKilometre  = 1000
+
+	DefaultMessageLimit = 20
 )
@@ -17,6 +17,8 @@ const (
 	ReceiveStockMutation          = "receive stock mutation"
 	UpdatedStock                  = "updated stock"
 	ReturnedStock                 = "returned stock"
-	DiagnoseStatus                = "Consulting"
+	ReservedStock                 = "reserved stock"
+	ReleasedStock                 = "released stock"
 	EndChat                       = "end chat"
+	Disputed                      = "disputed"
 )
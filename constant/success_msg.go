@@ -11,6 +11,8 @@ const (
 const (
 	LoginPassedMsg           = "login successfully"
 	LogoutMsg                = "logout successfully"
+	LogoutAllMsg             = "logout from all devices successfully"
+	RefreshTokenMsg          = "token refreshed successfully"
 	RegisterMsg              = "registered succesfully"
 	PasswordChangedMsg       = "password has been changed succesfully"
 	UsernameUpdatedMsg       = "profile updated"
@@ -25,4 +27,7 @@ const (
 	OrderCreatedSuccessfully = "the order created successfully"
 	OrderSend                = "the order has been sent by Pharmacy"
 	CancelOrderMsg           = "order was cancelled"
+	DisputeOrderMsg          = "order dispute was submitted"
+	ResolveDisputeMsg        = "order dispute was resolved"
+	CancelAppointmentMsg     = "appointment was cancelled"
 )
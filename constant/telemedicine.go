@@ -0,0 +1,17 @@
+package constant
+
+import "time"
+
+const (
+	TelemedicineQueueLockKey    = 1001
+	DefaultConsultationDuration = 15 * time.Minute
+	ConsultationDurationSample  = 20
+
+	JobCloseIdleTelemedicine = "close_idle_telemedicine"
+	JobOfflineIdleDoctor     = "offline_idle_doctor"
+
+	IdleTelemedicineClosedMsg = "session was closed automatically due to inactivity"
+
+	SessionConsulting = "consulting"
+	SessionEnded      = "ended"
+)
@@ -0,0 +1,21 @@
+\i database/sql/migration/refresh_tokens.sql
+\i database/sql/migration/sessions.sql
+\i database/sql/migration/message_attachments.sql
+\i database/sql/migration/telemedicine_payments.sql
+\i database/sql/migration/doctor_schedules.sql
+\i database/sql/migration/telemedicine_queue.sql
+\i database/sql/migration/doctor_reviews.sql
+\i database/sql/migration/prescription_redemptions.sql
+\i database/sql/migration/prescription_fills.sql
+\i database/sql/migration/prescription_lifecycle.sql
+\i database/sql/migration/medical_documents.sql
+\i database/sql/migration/clinical_notes.sql
+\i database/sql/migration/patient_record_access_logs.sql
+\i database/sql/migration/health_profiles.sql
+\i database/sql/migration/drug_interactions.sql
+\i database/sql/migration/dependents.sql
+\i database/sql/migration/payment_expiry.sql
+\i database/sql/migration/order_auto_confirm.sql
+\i database/sql/migration/order_status_histories.sql
+\i database/sql/migration/stock_reservation.sql
+\i database/sql/migration/appointment_refunds.sql
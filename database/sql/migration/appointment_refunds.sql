@@ -0,0 +1 @@
+ALTER TABLE payments ADD COLUMN refunded_at TIMESTAMP;
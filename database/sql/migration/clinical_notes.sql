@@ -0,0 +1,99 @@
+CREATE TABLE icd10_codes (
+	code VARCHAR(10) PRIMARY KEY,
+	description VARCHAR NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+INSERT INTO icd10_codes (code, description) VALUES
+	('A09', 'Infectious gastroenteritis and colitis, unspecified'),
+	('A90', 'Dengue fever [classical dengue]'),
+	('A91', 'Dengue haemorrhagic fever'),
+	('A01.0', 'Typhoid fever'),
+	('B01.9', 'Varicella without complication'),
+	('B34.9', 'Viral infection, unspecified'),
+	('B35.4', 'Tinea corporis'),
+	('B86', 'Scabies'),
+	('D64.9', 'Anaemia, unspecified'),
+	('E11.9', 'Type 2 diabetes mellitus without complications'),
+	('E78.5', 'Hyperlipidaemia, unspecified'),
+	('E86', 'Volume depletion'),
+	('F32.9', 'Depressive episode, unspecified'),
+	('F41.1', 'Generalized anxiety disorder'),
+	('F51.0', 'Nonorganic insomnia'),
+	('G43.9', 'Migraine, unspecified'),
+	('G44.2', 'Tension-type headache'),
+	('H10.9', 'Conjunctivitis, unspecified'),
+	('H66.9', 'Otitis media, unspecified'),
+	('I10', 'Essential (primary) hypertension'),
+	('J00', 'Acute nasopharyngitis [common cold]'),
+	('J01.9', 'Acute sinusitis, unspecified'),
+	('J02.9', 'Acute pharyngitis, unspecified'),
+	('J03.9', 'Acute tonsillitis, unspecified'),
+	('J06.9', 'Acute upper respiratory infection, unspecified'),
+	('J11.1', 'Influenza with other respiratory manifestations, virus not identified'),
+	('J18.9', 'Pneumonia, unspecified'),
+	('J20.9', 'Acute bronchitis, unspecified'),
+	('J30.4', 'Allergic rhinitis, unspecified'),
+	('J45.9', 'Asthma, unspecified'),
+	('K21.9', 'Gastro-oesophageal reflux disease without oesophagitis'),
+	('K29.7', 'Gastritis, unspecified'),
+	('K30', 'Functional dyspepsia'),
+	('K52.9', 'Noninfective gastroenteritis and colitis, unspecified'),
+	('K59.0', 'Constipation'),
+	('L20.9', 'Atopic dermatitis, unspecified'),
+	('L23.9', 'Allergic contact dermatitis, unspecified cause'),
+	('L50.9', 'Urticaria, unspecified'),
+	('L70.0', 'Acne vulgaris'),
+	('M54.5', 'Low back pain'),
+	('M79.1', 'Myalgia'),
+	('N39.0', 'Urinary tract infection, site not specified'),
+	('R05', 'Cough'),
+	('R10.4', 'Other and unspecified abdominal pain'),
+	('R11', 'Nausea and vomiting'),
+	('R50.9', 'Fever, unspecified'),
+	('R51', 'Headache'),
+	('R53', 'Malaise and fatigue'),
+	('T78.4', 'Allergy, unspecified'),
+	('Z00.0', 'General medical examination');
+
+CREATE TABLE clinical_notes (
+	clinical_note_id BIGSERIAL PRIMARY KEY,
+	telemedicine_id BIGINT NOT NULL UNIQUE REFERENCES telemedicines (telemedicine_id),
+	subjective TEXT,
+	objective TEXT,
+	assessment TEXT,
+	plan TEXT,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE TABLE clinical_note_diagnoses (
+	clinical_note_diagnosis_id BIGSERIAL PRIMARY KEY,
+	clinical_note_id BIGINT NOT NULL REFERENCES clinical_notes (clinical_note_id),
+	icd10_code VARCHAR(10) NOT NULL REFERENCES icd10_codes (code),
+	is_primary BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (clinical_note_id, icd10_code)
+);
+
+CREATE UNIQUE INDEX clinical_note_diagnoses_primary_idx ON clinical_note_diagnoses (clinical_note_id) WHERE is_primary;
+
+ALTER TABLE telemedicines ADD COLUMN session_status VARCHAR NOT NULL DEFAULT 'consulting' CHECK (session_status IN ('consulting', 'ended'));
+
+UPDATE telemedicines SET session_status = 'ended' WHERE end_at IS NOT NULL;
+
+INSERT INTO clinical_notes (telemedicine_id, assessment)
+	SELECT
+		telemedicine_id, diagnose
+	FROM
+		telemedicines
+	WHERE
+		diagnose IS NOT NULL
+	AND
+		diagnose <> 'Consulting';
+
+ALTER TABLE telemedicines DROP COLUMN diagnose;
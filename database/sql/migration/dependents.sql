@@ -0,0 +1,15 @@
+CREATE TABLE dependents (
+	dependent_id BIGSERIAL PRIMARY KEY,
+	user_id BIGINT NOT NULL REFERENCES users (user_id),
+	dependent_name VARCHAR NOT NULL,
+	date_of_birth DATE NOT NULL,
+	gender VARCHAR NOT NULL CHECK (gender IN ('male', 'female')),
+	relationship VARCHAR NOT NULL CHECK (relationship IN ('child', 'parent', 'spouse', 'sibling', 'other')),
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE INDEX dependents_user_id_idx ON dependents (user_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE telemedicines ADD COLUMN dependent_id BIGINT REFERENCES dependents (dependent_id);
@@ -0,0 +1,17 @@
+ALTER TABLE doctors ADD COLUMN rating_average NUMERIC(3, 2) NOT NULL DEFAULT 0;
+ALTER TABLE doctors ADD COLUMN rating_count INT NOT NULL DEFAULT 0;
+
+CREATE TABLE doctor_reviews (
+	doctor_review_id BIGSERIAL PRIMARY KEY,
+	telemedicine_id BIGINT NOT NULL UNIQUE REFERENCES telemedicines (telemedicine_id),
+	doctor_id BIGINT NOT NULL REFERENCES doctors (doctor_id),
+	user_id BIGINT NOT NULL REFERENCES users (user_id),
+	rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+	review TEXT,
+	is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE INDEX doctor_reviews_doctor_id_idx ON doctor_reviews (doctor_id) WHERE is_hidden = FALSE AND deleted_at IS NULL;
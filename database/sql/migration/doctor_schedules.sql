@@ -0,0 +1,59 @@
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE doctor_schedules (
+	doctor_schedule_id BIGSERIAL PRIMARY KEY,
+	doctor_id BIGINT NOT NULL REFERENCES doctors (doctor_id),
+	day_of_week SMALLINT NOT NULL CHECK (day_of_week BETWEEN 0 AND 6),
+	start_time TIME NOT NULL,
+	end_time TIME NOT NULL,
+	slot_duration INT NOT NULL CHECK (slot_duration > 0),
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP,
+	CHECK (start_time < end_time),
+	CONSTRAINT doctor_schedules_no_overlap EXCLUDE USING gist (
+		doctor_id WITH =,
+		day_of_week WITH =,
+		tsrange(DATE '2000-01-01' + start_time, DATE '2000-01-01' + end_time) WITH &&
+	) WHERE (deleted_at IS NULL)
+);
+
+CREATE TABLE doctor_schedule_exceptions (
+	doctor_schedule_exception_id BIGSERIAL PRIMARY KEY,
+	doctor_id BIGINT NOT NULL REFERENCES doctors (doctor_id),
+	exception_date DATE NOT NULL,
+	start_time TIME,
+	end_time TIME,
+	reason VARCHAR,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP,
+	CHECK ((start_time IS NULL AND end_time IS NULL) OR start_time < end_time)
+);
+
+CREATE INDEX doctor_schedule_exceptions_doctor_date_idx ON doctor_schedule_exceptions (doctor_id, exception_date);
+
+ALTER TABLE telemedicines ADD COLUMN start_at TIMESTAMP;
+
+CREATE TABLE appointments (
+	appointment_id BIGSERIAL PRIMARY KEY,
+	doctor_id BIGINT NOT NULL REFERENCES doctors (doctor_id),
+	user_id BIGINT NOT NULL REFERENCES users (user_id),
+	telemedicine_id BIGINT NOT NULL REFERENCES telemedicines (telemedicine_id),
+	start_at TIMESTAMP NOT NULL,
+	end_at TIMESTAMP NOT NULL,
+	status VARCHAR NOT NULL DEFAULT 'booked',
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	CHECK (start_at < end_at),
+	CONSTRAINT appointments_doctor_no_overlap EXCLUDE USING gist (
+		doctor_id WITH =,
+		tsrange(start_at, end_at) WITH &&
+	) WHERE (status = 'booked'),
+	CONSTRAINT appointments_user_no_overlap EXCLUDE USING gist (
+		user_id WITH =,
+		tsrange(start_at, end_at) WITH &&
+	) WHERE (status = 'booked')
+);
+
+CREATE INDEX appointments_telemedicine_id_idx ON appointments (telemedicine_id);
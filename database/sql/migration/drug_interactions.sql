@@ -0,0 +1,26 @@
+CREATE TABLE drug_interactions (
+	drug_interaction_id BIGSERIAL PRIMARY KEY,
+	ingredient_a VARCHAR NOT NULL,
+	ingredient_b VARCHAR NOT NULL,
+	severity VARCHAR NOT NULL CHECK (severity IN ('minor', 'moderate', 'severe')),
+	description TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP,
+	CHECK (lower(ingredient_a) <> lower(ingredient_b))
+);
+
+CREATE UNIQUE INDEX drug_interactions_pair_idx ON drug_interactions ((LEAST(lower(ingredient_a), lower(ingredient_b))), (GREATEST(lower(ingredient_a), lower(ingredient_b)))) WHERE deleted_at IS NULL;
+
+INSERT INTO drug_interactions (ingredient_a, ingredient_b, severity, description) VALUES
+	('warfarin', 'aspirin', 'severe', 'Combined anticoagulant and antiplatelet effect greatly increases the risk of bleeding.'),
+	('warfarin', 'ibuprofen', 'severe', 'NSAIDs increase the anticoagulant effect of warfarin and the risk of gastrointestinal bleeding.'),
+	('sildenafil', 'nitroglycerin', 'severe', 'Concurrent use can cause profound, potentially fatal hypotension.'),
+	('simvastatin', 'clarithromycin', 'severe', 'Clarithromycin raises simvastatin levels and the risk of rhabdomyolysis.'),
+	('methotrexate', 'trimethoprim', 'severe', 'Both are folate antagonists; combined use can cause bone marrow suppression.'),
+	('ibuprofen', 'aspirin', 'moderate', 'Ibuprofen may reduce the cardioprotective effect of low-dose aspirin and adds gastrointestinal risk.'),
+	('amlodipine', 'simvastatin', 'moderate', 'Amlodipine increases simvastatin exposure; simvastatin dose should not exceed 20 mg daily.'),
+	('metformin', 'furosemide', 'moderate', 'Furosemide may increase metformin levels; monitor blood glucose and renal function.'),
+	('ciprofloxacin', 'aluminium hydroxide', 'moderate', 'Aluminium-containing antacids reduce ciprofloxacin absorption; separate doses by at least 2 hours.'),
+	('paracetamol', 'warfarin', 'moderate', 'Regular paracetamol use may increase the INR in patients taking warfarin.'),
+	('cetirizine', 'diphenhydramine', 'minor', 'Combined antihistamines may increase drowsiness.');
@@ -0,0 +1,48 @@
+CREATE TABLE health_profiles (
+	health_profile_id BIGSERIAL PRIMARY KEY,
+	user_id BIGINT NOT NULL UNIQUE REFERENCES users (user_id),
+	weight_kg NUMERIC(5, 2) CHECK (weight_kg > 0),
+	height_cm NUMERIC(5, 2) CHECK (height_cm > 0),
+	is_pregnant BOOLEAN,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE TABLE user_allergies (
+	user_allergy_id BIGSERIAL PRIMARY KEY,
+	user_id BIGINT NOT NULL REFERENCES users (user_id),
+	allergen VARCHAR NOT NULL,
+	reaction VARCHAR,
+	severity VARCHAR NOT NULL DEFAULT 'mild' CHECK (severity IN ('mild', 'moderate', 'severe')),
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE TABLE user_chronic_conditions (
+	user_chronic_condition_id BIGSERIAL PRIMARY KEY,
+	user_id BIGINT NOT NULL REFERENCES users (user_id),
+	condition_name VARCHAR NOT NULL,
+	icd10_code VARCHAR(10) REFERENCES icd10_codes (code),
+	notes TEXT,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE TABLE user_medications (
+	user_medication_id BIGSERIAL PRIMARY KEY,
+	user_id BIGINT NOT NULL REFERENCES users (user_id),
+	medication_name VARCHAR NOT NULL,
+	drug_id BIGINT REFERENCES drugs (drug_id),
+	dosage VARCHAR,
+	started_at DATE,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE INDEX user_allergies_user_id_idx ON user_allergies (user_id) WHERE deleted_at IS NULL;
+CREATE INDEX user_chronic_conditions_user_id_idx ON user_chronic_conditions (user_id) WHERE deleted_at IS NULL;
+CREATE INDEX user_medications_user_id_idx ON user_medications (user_id) WHERE deleted_at IS NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE medical_documents (
+	medical_document_id BIGSERIAL PRIMARY KEY,
+	code VARCHAR NOT NULL UNIQUE,
+	type VARCHAR NOT NULL CHECK (type IN ('certificate', 'prescription')),
+	telemedicine_id BIGINT NOT NULL REFERENCES telemedicines (telemedicine_id),
+	document_hash VARCHAR NOT NULL,
+	signature VARCHAR NOT NULL,
+	issued_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	valid_until TIMESTAMP,
+	superseded_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE INDEX medical_documents_telemedicine_id_idx ON medical_documents (telemedicine_id, type) WHERE deleted_at IS NULL;
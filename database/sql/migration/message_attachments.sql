@@ -0,0 +1,10 @@
+CREATE TABLE message_attachments (
+	message_attachment_id BIGSERIAL PRIMARY KEY,
+	message_bubble_id BIGINT NOT NULL UNIQUE REFERENCES message_bubbles (message_bubble_id),
+	mime_type VARCHAR NOT NULL,
+	size BIGINT NOT NULL,
+	original_name VARCHAR NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
@@ -0,0 +1,4 @@
+ALTER TABLE orders ADD COLUMN disputed_at TIMESTAMP;
+ALTER TABLE orders ADD COLUMN dispute_reason TEXT;
+
+CREATE INDEX orders_sent_finished_at_idx ON orders (finished_at) WHERE status = 'sent' AND deleted_at IS NULL;
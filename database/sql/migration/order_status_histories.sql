@@ -0,0 +1,23 @@
+CREATE TABLE order_status_histories (
+	order_status_history_id BIGSERIAL PRIMARY KEY,
+	order_id BIGINT NOT NULL REFERENCES orders (order_id),
+	from_status VARCHAR,
+	to_status VARCHAR NOT NULL,
+	actor_role VARCHAR NOT NULL CHECK (actor_role IN ('user', 'manager', 'admin', 'system')),
+	actor_id BIGINT,
+	reason TEXT,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE INDEX order_status_histories_order_id_idx ON order_status_histories (order_id, created_at);
+
+INSERT INTO
+	order_status_histories (order_id, from_status, to_status, actor_role, reason, created_at)
+SELECT
+	order_id, NULL, status, 'system', 'recorded before status history was tracked', COALESCE(updated_at, created_at)
+FROM
+	orders
+WHERE
+	deleted_at IS NULL;
@@ -0,0 +1,16 @@
+CREATE TABLE patient_record_access_logs (
+	patient_record_access_log_id BIGSERIAL PRIMARY KEY,
+	doctor_id BIGINT NOT NULL REFERENCES doctors (doctor_id),
+	user_id BIGINT NOT NULL REFERENCES users (user_id),
+	telemedicine_id BIGINT NOT NULL REFERENCES telemedicines (telemedicine_id),
+	ip_address VARCHAR,
+	user_agent VARCHAR,
+	accessed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE INDEX patient_record_access_logs_user_id_idx ON patient_record_access_logs (user_id, accessed_at DESC);
+
+CREATE INDEX telemedicines_user_id_end_at_idx ON telemedicines (user_id, end_at DESC) WHERE deleted_at IS NULL;
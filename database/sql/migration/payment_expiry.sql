@@ -0,0 +1,5 @@
+ALTER TABLE payments ADD COLUMN is_expired BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE payments SET is_expired = TRUE WHERE payment_proof IS NULL AND payment_expired_at < now() AND deleted_at IS NULL;
+
+CREATE INDEX payments_pending_expiry_idx ON payments (payment_expired_at) WHERE payment_proof IS NULL AND is_expired = FALSE AND deleted_at IS NULL;
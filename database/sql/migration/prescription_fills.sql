@@ -0,0 +1,32 @@
+ALTER TABLE prescriptions ADD COLUMN expired_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP + INTERVAL '30 days');
+
+UPDATE prescriptions SET expired_at = created_at + INTERVAL '30 days';
+
+CREATE TABLE prescription_fills (
+	prescription_fill_id BIGSERIAL PRIMARY KEY,
+	prescription_id BIGINT NOT NULL REFERENCES prescriptions (prescription_id),
+	order_detail_id BIGINT NOT NULL UNIQUE REFERENCES order_details (order_detail_id),
+	quantity INT NOT NULL CHECK (quantity > 0),
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	deleted_at TIMESTAMP
+);
+
+CREATE INDEX prescription_fills_prescription_id_idx ON prescription_fills (prescription_id);
+
+CREATE VIEW prescription_fill_summaries AS
+	SELECT
+		pf.prescription_id,
+		SUM(pf.quantity) AS redeemed_quantity
+	FROM
+		prescription_fills pf
+	JOIN
+		order_details od ON od.order_detail_id = pf.order_detail_id
+	JOIN
+		orders o ON o.order_id = od.order_id
+	WHERE
+		pf.deleted_at IS NULL
+	AND
+		o.status <> 'cancelled'
+	GROUP BY
+		pf.prescription_id;
@@ -0,0 +1,6 @@
+ALTER TABLE prescriptions ADD COLUMN issued_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE prescriptions ADD COLUMN frequency VARCHAR;
+ALTER TABLE prescriptions ADD COLUMN duration_days INT CHECK (duration_days > 0);
+ALTER TABLE prescriptions ADD COLUMN route VARCHAR;
+
+UPDATE prescriptions SET issued_at = created_at;
@@ -0,0 +1,3 @@
+ALTER TABLE cart_items ADD COLUMN prescription_id BIGINT REFERENCES prescriptions (prescription_id);
+
+CREATE UNIQUE INDEX cart_items_prescription_id_idx ON cart_items (prescription_id) WHERE deleted_at IS NULL;
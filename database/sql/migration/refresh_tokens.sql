@@ -0,0 +1,15 @@
+CREATE TABLE refresh_tokens (
+	refresh_token_id BIGSERIAL PRIMARY KEY,
+	actor_type VARCHAR NOT NULL,
+	actor_id BIGINT NOT NULL,
+	actor_email VARCHAR NOT NULL,
+	token VARCHAR NOT NULL UNIQUE,
+	family VARCHAR NOT NULL,
+	expired_at TIMESTAMP NOT NULL,
+	used_at TIMESTAMP,
+	revoked_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX refresh_tokens_family_idx ON refresh_tokens (family);
@@ -0,0 +1,10 @@
+CREATE TABLE sessions (
+	session_id VARCHAR PRIMARY KEY,
+	actor_type VARCHAR NOT NULL,
+	actor_id BIGINT NOT NULL,
+	revoked_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX sessions_actor_idx ON sessions (actor_type, actor_id);
@@ -0,0 +1,6 @@
+ALTER TABLE pharmacy_drugs ADD COLUMN reserved_stock INT NOT NULL DEFAULT 0 CHECK (reserved_stock >= 0);
+ALTER TABLE pharmacy_drugs ADD CONSTRAINT pharmacy_drugs_stock_reserved_check CHECK (stock >= reserved_stock);
+
+ALTER TABLE order_details ADD COLUMN is_stock_reserved BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX order_details_stock_reserved_idx ON order_details (order_id) WHERE is_stock_reserved;
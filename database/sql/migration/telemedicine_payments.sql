@@ -0,0 +1,5 @@
+ALTER TABLE telemedicines
+	ADD COLUMN payment_id BIGINT REFERENCES payments (payment_id),
+	ADD COLUMN status VARCHAR NOT NULL DEFAULT 'payment confirmed';
+
+CREATE INDEX telemedicines_payment_id_idx ON telemedicines (payment_id);
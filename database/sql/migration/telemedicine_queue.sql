@@ -0,0 +1,5 @@
+ALTER TABLE telemedicines ADD COLUMN admitted_at TIMESTAMP;
+
+UPDATE telemedicines SET admitted_at = COALESCE(start_at, created_at);
+
+CREATE INDEX telemedicines_queue_idx ON telemedicines (doctor_id, telemedicine_id) WHERE admitted_at IS NULL AND end_at IS NULL;
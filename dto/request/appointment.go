@@ -0,0 +1,31 @@
+package request
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type BookAppointment struct {
+	DoctorID uint   `json:"doctor_id" binding:"required"`
+	StartAt  string `json:"start_at" binding:"required,datetime"`
+}
+
+type RescheduleAppointment struct {
+	StartAt string `json:"start_at" binding:"required,datetime"`
+}
+
+func (req *BookAppointment) Appointment() entity.Appointment {
+	startAt, _ := time.Parse(constant.FullTimeFormat, req.StartAt)
+
+	return entity.Appointment{
+		DoctorID: req.DoctorID,
+		StartAt:  startAt,
+	}
+}
+
+func (req *RescheduleAppointment) ParsedStartAt() time.Time {
+	startAt, _ := time.Parse(constant.FullTimeFormat, req.StartAt)
+	return startAt
+}
@@ -3,9 +3,8 @@ package request
 import "Alice-Seahat-Healthcare/seahat-be/entity"
 
 type AddCartItemRequest struct {
-	PharmacyDrugID int   `json:"pharmacy_drug_id" binding:"required,gte=1"`
-	Quantity       int   `json:"quantity" binding:"required,gte=1"`
-	IsPrescripted  *bool `json:"is_prescripted" binding:"required,boolean"`
+	PharmacyDrugID int `json:"pharmacy_drug_id" binding:"required,gte=1"`
+	Quantity       int `json:"quantity" binding:"required,gte=1"`
 }
 
 type UpdateQtyItemRequest struct {
@@ -24,7 +23,6 @@ func (req AddCartItemRequest) CartItem() entity.CartItem {
 	return entity.CartItem{
 		PharmacyDrugID: uint(req.PharmacyDrugID),
 		Quantity:       uint(req.Quantity),
-		IsPrescripted:  *req.IsPrescripted,
 	}
 }
 
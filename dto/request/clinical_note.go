@@ -0,0 +1,35 @@
+package request
+
+import "Alice-Seahat-Healthcare/seahat-be/entity"
+
+type ClinicalNoteDiagnosis struct {
+	Code      string `json:"code" binding:"required,max=10"`
+	IsPrimary bool   `json:"is_primary"`
+}
+
+type ClinicalNote struct {
+	Subjective *string                 `json:"subjective" binding:"omitempty,max=5000"`
+	Objective  *string                 `json:"objective" binding:"omitempty,max=5000"`
+	Assessment *string                 `json:"assessment" binding:"omitempty,max=5000"`
+	Plan       *string                 `json:"plan" binding:"omitempty,max=5000"`
+	Diagnoses  []ClinicalNoteDiagnosis `json:"diagnoses" binding:"required,gt=0,dive"`
+}
+
+func (req *ClinicalNote) ClinicalNote(telemedicineID uint) entity.ClinicalNote {
+	diagnoses := make([]entity.ClinicalNoteDiagnosis, 0)
+	for _, diagnosis := range req.Diagnoses {
+		diagnoses = append(diagnoses, entity.ClinicalNoteDiagnosis{
+			ICD10Code: entity.ICD10Code{Code: diagnosis.Code},
+			IsPrimary: diagnosis.IsPrimary,
+		})
+	}
+
+	return entity.ClinicalNote{
+		TelemedicineID: telemedicineID,
+		Subjective:     req.Subjective,
+		Objective:      req.Objective,
+		Assessment:     req.Assessment,
+		Plan:           req.Plan,
+		Diagnoses:      diagnoses,
+	}
+}
@@ -0,0 +1,26 @@
+package request
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type Dependent struct {
+	Name         string `json:"name" binding:"required,min=2"`
+	DateOfBirth  string `json:"date_of_birth" binding:"required,date"`
+	Gender       string `json:"gender" binding:"required,oneof=male female"`
+	Relationship string `json:"relationship" binding:"required,oneof=child parent spouse sibling other"`
+}
+
+func (req *Dependent) Dependent() entity.Dependent {
+	dateOfBirth, _ := time.Parse(constant.DateFormat, req.DateOfBirth)
+
+	return entity.Dependent{
+		Name:         req.Name,
+		DateOfBirth:  dateOfBirth,
+		Gender:       req.Gender,
+		Relationship: req.Relationship,
+	}
+}
@@ -0,0 +1,20 @@
+package request
+
+import "Alice-Seahat-Healthcare/seahat-be/entity"
+
+type DoctorReview struct {
+	Rating int     `json:"rating" binding:"required,gte=1,lte=5"`
+	Review *string `json:"review" binding:"omitempty,max=1000"`
+}
+
+type DoctorReviewVisibility struct {
+	IsHidden *bool `json:"is_hidden" binding:"required"`
+}
+
+func (req *DoctorReview) DoctorReview(telemedicineID uint) entity.DoctorReview {
+	return entity.DoctorReview{
+		TelemedicineID: telemedicineID,
+		Rating:         req.Rating,
+		Review:         req.Review,
+	}
+}
@@ -0,0 +1,51 @@
+package request
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type DoctorSchedule struct {
+	DayOfWeek    *int   `json:"day_of_week" binding:"required,gte=0,lte=6"`
+	StartTime    string `json:"start_time" binding:"required,time"`
+	EndTime      string `json:"end_time" binding:"required,time"`
+	SlotDuration int    `json:"slot_duration" binding:"required,gte=10,lte=240"`
+}
+
+type DoctorScheduleException struct {
+	Date      string  `json:"date" binding:"required,date"`
+	StartTime *string `json:"start_time" binding:"required_with=EndTime,omitempty,time"`
+	EndTime   *string `json:"end_time" binding:"required_with=StartTime,omitempty,time"`
+	Reason    *string `json:"reason" binding:"omitempty,max=255"`
+}
+
+type SlotQuery struct {
+	Date string `form:"date" binding:"required,date"`
+}
+
+func (req *DoctorSchedule) DoctorSchedule() entity.DoctorSchedule {
+	return entity.DoctorSchedule{
+		DayOfWeek:    *req.DayOfWeek,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		SlotDuration: req.SlotDuration,
+	}
+}
+
+func (req *DoctorScheduleException) DoctorScheduleException() entity.DoctorScheduleException {
+	date, _ := time.Parse(constant.DateFormat, req.Date)
+
+	return entity.DoctorScheduleException{
+		Date:      date,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Reason:    req.Reason,
+	}
+}
+
+func (req *SlotQuery) ParsedDate() time.Time {
+	date, _ := time.Parse(constant.DateFormat, req.Date)
+	return date
+}
@@ -0,0 +1,28 @@
+package request
+
+import "Alice-Seahat-Healthcare/seahat-be/entity"
+
+type DrugInteraction struct {
+	IngredientA string `json:"ingredient_a" binding:"required,max=255"`
+	IngredientB string `json:"ingredient_b" binding:"required,max=255,nefield=IngredientA"`
+	Severity    string `json:"severity" binding:"required,oneof=minor moderate severe"`
+	Description string `json:"description" binding:"required,max=5000"`
+}
+
+type ImportDrugInteraction struct {
+	Interactions []DrugInteraction `json:"interactions" binding:"required,gt=0,max=1000,dive"`
+}
+
+func (req *ImportDrugInteraction) DrugInteractions() []entity.DrugInteraction {
+	interactions := make([]entity.DrugInteraction, 0)
+	for _, interaction := range req.Interactions {
+		interactions = append(interactions, entity.DrugInteraction{
+			IngredientA: interaction.IngredientA,
+			IngredientB: interaction.IngredientB,
+			Severity:    interaction.Severity,
+			Description: interaction.Description,
+		})
+	}
+
+	return interactions
+}
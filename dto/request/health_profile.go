@@ -0,0 +1,87 @@
+package request
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type Allergy struct {
+	Allergen string  `json:"allergen" binding:"required,min=2,max=100"`
+	Reaction *string `json:"reaction" binding:"omitempty,max=255"`
+	Severity string  `json:"severity" binding:"required,oneof=mild moderate severe"`
+}
+
+type ChronicCondition struct {
+	Name      string  `json:"name" binding:"required,max=255"`
+	ICD10Code *string `json:"icd10_code" binding:"omitempty,max=10"`
+	Notes     *string `json:"notes" binding:"omitempty,max=1000"`
+}
+
+type Medication struct {
+	Name      string  `json:"name" binding:"required,max=255"`
+	DrugID    *int    `json:"drug_id" binding:"omitempty,gte=1"`
+	Dosage    *string `json:"dosage" binding:"omitempty,max=255"`
+	StartedAt string  `json:"started_at" binding:"omitempty,date"`
+}
+
+type HealthProfile struct {
+	WeightKg          *float64           `json:"weight_kg" binding:"omitempty,gt=0,lt=1000"`
+	HeightCm          *float64           `json:"height_cm" binding:"omitempty,gt=0,lt=1000"`
+	IsPregnant        *bool              `json:"is_pregnant"`
+	Allergies         []Allergy          `json:"allergies" binding:"dive"`
+	ChronicConditions []ChronicCondition `json:"chronic_conditions" binding:"dive"`
+	Medications       []Medication       `json:"medications" binding:"dive"`
+}
+
+func (req *HealthProfile) HealthProfile() entity.HealthProfile {
+	allergies := make([]entity.Allergy, 0)
+	for _, allergy := range req.Allergies {
+		allergies = append(allergies, entity.Allergy{
+			Allergen: allergy.Allergen,
+			Reaction: allergy.Reaction,
+			Severity: allergy.Severity,
+		})
+	}
+
+	conditions := make([]entity.ChronicCondition, 0)
+	for _, condition := range req.ChronicConditions {
+		conditions = append(conditions, entity.ChronicCondition{
+			Name:      condition.Name,
+			ICD10Code: condition.ICD10Code,
+			Notes:     condition.Notes,
+		})
+	}
+
+	medications := make([]entity.Medication, 0)
+	for _, medication := range req.Medications {
+		var drugID *uint
+		if medication.DrugID != nil {
+			id := uint(*medication.DrugID)
+			drugID = &id
+		}
+
+		var startedAt *time.Time
+		if medication.StartedAt != "" {
+			date, _ := time.Parse(constant.DateFormat, medication.StartedAt)
+			startedAt = &date
+		}
+
+		medications = append(medications, entity.Medication{
+			Name:      medication.Name,
+			DrugID:    drugID,
+			Dosage:    medication.Dosage,
+			StartedAt: startedAt,
+		})
+	}
+
+	return entity.HealthProfile{
+		WeightKg:          req.WeightKg,
+		HeightCm:          req.HeightCm,
+		IsPregnant:        req.IsPregnant,
+		Allergies:         allergies,
+		ChronicConditions: conditions,
+		Medications:       medications,
+	}
+}
@@ -1,19 +1,58 @@
 package request
 
-import "Alice-Seahat-Healthcare/seahat-be/entity"
+import (
+	"mime/multipart"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
 
 type MessageBubble struct {
 	TelemedicineID uint   `json:"telemedicine_id" binding:"required"`
-	SenderType     string `json:"sender_type" binding:"required,oneof=doctor user"`
 	Payload        string `json:"payload" binding:"required"`
-	PayloadType    string `json:"payload_type" binding:"required,oneof=text image document"`
+	PayloadType    string `json:"payload_type" binding:"required,oneof=text"`
+}
+
+type MessageAttachment struct {
+	File multipart.FileHeader `form:"file" binding:"required"`
+	Type string               `form:"type" binding:"required,oneof=pdf image"`
+}
+
+type MessageBubbleQuery struct {
+	Before uint `form:"before" binding:"omitempty,gte=1"`
+	After  uint `form:"after" binding:"omitempty,gte=1"`
+	Limit  uint `form:"limit" binding:"omitempty,gte=1,lte=100"`
+}
+
+func (req *MessageBubbleQuery) Cursor() entity.Cursor {
+	limit := req.Limit
+	if limit == 0 {
+		limit = constant.DefaultMessageLimit
+	}
+
+	return entity.Cursor{
+		Before: req.Before,
+		After:  req.After,
+		Limit:  limit,
+	}
 }
 
 func (req *MessageBubble) MessageBubble() entity.MessageBubble {
 	return entity.MessageBubble{
 		TelemedicineID: req.TelemedicineID,
-		SenderType:     req.SenderType,
 		PayloadType:    req.PayloadType,
 		Payload:        req.Payload,
 	}
 }
+
+func (req *MessageAttachment) MessageBubble(telemedicineID uint, mimeType string) entity.MessageBubble {
+	return entity.MessageBubble{
+		TelemedicineID: telemedicineID,
+		PayloadType:    constant.AttachmentPayloadType[req.Type],
+		Attachment: &entity.MessageAttachment{
+			MimeType:     mimeType,
+			Size:         req.File.Size,
+			OriginalName: req.File.Filename,
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package request
 
 import (
+	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 )
 
@@ -15,6 +16,23 @@ type Order struct {
 	ShipmentId  uint   `json:"shipment_id" binding:"gt=0,gte=1, required" `
 }
 
+type OrderDispute struct {
+	Reason string `json:"reason" binding:"required,min=5,max=1000"`
+}
+
+type OrderResolveDispute struct {
+	Action string `json:"action" binding:"required,oneof=confirm cancel"`
+	Reason string `json:"reason" binding:"required,min=5,max=1000"`
+}
+
+func (req *OrderResolveDispute) Status() string {
+	if req.Action == "cancel" {
+		return constant.Cancelled
+	}
+
+	return constant.OrderConfirmed
+}
+
 func (req *CreateOrder) OrderDTO() []entity.Order {
 	orders := make([]entity.Order, 0)
 	payment := NewPayment(req.Payment)
@@ -1,11 +1,20 @@
 package request
 
-import "Alice-Seahat-Healthcare/seahat-be/entity"
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
 
 type AddPrescriptionItem struct {
-	DrugID   int    `json:"drug_id" binding:"required,gte=1"`
-	Quantity int    `json:"quantity" binding:"required,gte=1"`
-	Notes    string `json:"notes" binding:"required,gte=1"`
+	DrugID       int     `json:"drug_id" binding:"required,gte=1"`
+	Quantity     int     `json:"quantity" binding:"required,gte=1"`
+	Notes        string  `json:"notes" binding:"required,gte=1"`
+	Frequency    *string `json:"frequency" binding:"omitempty,min=1,max=100"`
+	DurationDays *int    `json:"duration_days" binding:"omitempty,gte=1,lte=365"`
+	Route        *string `json:"route" binding:"omitempty,oneof=oral topical injection inhalation sublingual rectal other"`
+	ValidDays    *int    `json:"valid_days" binding:"omitempty,gte=1,lte=180"`
 }
 
 type AddPrescriptionRequest struct {
@@ -14,13 +23,30 @@ type AddPrescriptionRequest struct {
 
 func (req *AddPrescriptionRequest) Prescription(telemedicineID uint) []entity.Prescription {
 	datas := make([]entity.Prescription, 0)
+	issuedAt := time.Now()
 
 	for _, p := range req.Prescriptions {
+		validDays := constant.PrescriptionValidDays
+		if p.ValidDays != nil {
+			validDays = *p.ValidDays
+		}
+
+		var durationDays *uint
+		if p.DurationDays != nil {
+			days := uint(*p.DurationDays)
+			durationDays = &days
+		}
+
 		datas = append(datas, entity.Prescription{
 			TelemedicineID: telemedicineID,
 			DrugID:         uint(p.DrugID),
 			Quantity:       uint(p.Quantity),
 			Notes:          p.Notes,
+			Frequency:      p.Frequency,
+			DurationDays:   durationDays,
+			Route:          p.Route,
+			IssuedAt:       issuedAt,
+			ExpiredAt:      issuedAt.AddDate(0, 0, validDays),
 		})
 	}
 
@@ -8,10 +8,10 @@ import (
 )
 
 type TelemedicineReq struct {
-	EndAt          string  `json:"end_at" `
-	Diagnose       *string `json:"diagnose" binding:"required,min=4"`
-	StartRestAt    string  `json:"start_rest_at"`
-	RestDuration   *int    `json:"rest_duration"`
+	EndAt          string        `json:"end_at" `
+	Note           *ClinicalNote `json:"note"`
+	StartRestAt    string        `json:"start_rest_at"`
+	RestDuration   *int          `json:"rest_duration"`
 	TelemedicineID uint
 }
 
@@ -20,22 +20,29 @@ func (req *TelemedicineReq) Telemedicine() entity.Telemedicine {
 
 	startRestAt, _ := time.Parse(layoutFormat, req.StartRestAt)
 	endAt, _ := time.Parse(layoutFormat, req.EndAt)
+
+	var note *entity.ClinicalNote
+	if req.Note != nil {
+		clinicalNote := req.Note.ClinicalNote(req.TelemedicineID)
+		note = &clinicalNote
+	}
+
 	return entity.Telemedicine{
 		ID:           req.TelemedicineID,
 		EndAt:        &endAt,
-		Diagnose:     req.Diagnose,
 		StartRestAt:  &startRestAt,
 		RestDuration: req.RestDuration,
+		ClinicalNote: note,
 	}
 }
 
 type AddTelemedicine struct {
-	DoctorID uint `json:"doctor_id" binding:"required"`
+	DoctorID    uint  `json:"doctor_id" binding:"required"`
+	DependentID *uint `json:"dependent_id" binding:"omitempty,gt=0"`
 }
 
 type PutTelemedicine struct {
 	EndAt                 string `json:"end_at" binding:"required"`
-	Diagnose              string `json:"diagnose" binding:"required"`
 	StartRestAt           string `json:"start_rest_at" binding:"required"`
 	RestDuration          int    `json:"rest_duration" binding:"required"`
 	MedicalCertificateURL string `json:"medical_certificate_url" binding:"required"`
@@ -44,7 +51,8 @@ type PutTelemedicine struct {
 func (req *AddTelemedicine) Telemedicine() entity.Telemedicine {
 	doctor := entity.Doctor{ID: req.DoctorID}
 	return entity.Telemedicine{
-		Doctor: doctor,
+		Doctor:      doctor,
+		DependentID: req.DependentID,
 	}
 }
 
@@ -55,7 +63,6 @@ func (req *PutTelemedicine) Telemedicine(id int) entity.Telemedicine {
 
 	return entity.Telemedicine{
 		ID:                    uint(id),
-		Diagnose:              &req.Diagnose,
 		StartRestAt:           &startRestAt,
 		RestDuration:          &req.RestDuration,
 		MedicalCertificateURL: &req.MedicalCertificateURL,
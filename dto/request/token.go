@@ -0,0 +1,24 @@
+package request
+
+import (
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RefreshToken struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+func GetRefreshToken(ctx *gin.Context) (string, error) {
+	if token, err := ctx.Cookie(constant.TokenRefresh); err == nil && token != "" {
+		return token, nil
+	}
+
+	body := new(RefreshToken)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		return "", err
+	}
+
+	return body.RefreshToken, nil
+}
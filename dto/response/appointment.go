@@ -0,0 +1,41 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type AppointmentDTO struct {
+	ID             uint      `json:"id"`
+	DoctorID       uint      `json:"doctor_id"`
+	UserID         uint      `json:"user_id"`
+	TelemedicineID uint      `json:"telemedicine_id"`
+	StartAt        time.Time `json:"start_at"`
+	EndAt          time.Time `json:"end_at"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func NewAppointmentDTO(a entity.Appointment) AppointmentDTO {
+	return AppointmentDTO{
+		ID:             a.ID,
+		DoctorID:       a.DoctorID,
+		UserID:         a.UserID,
+		TelemedicineID: a.TelemedicineID,
+		StartAt:        a.StartAt,
+		EndAt:          a.EndAt,
+		Status:         a.Status,
+		CreatedAt:      a.CreatedAt,
+	}
+}
+
+func NewMultipleAppointmentDTO(appointments []entity.Appointment) []AppointmentDTO {
+	dtos := make([]AppointmentDTO, 0)
+
+	for _, appointment := range appointments {
+		dtos = append(dtos, NewAppointmentDTO(appointment))
+	}
+
+	return dtos
+}
@@ -12,6 +12,7 @@ type CartItemDto struct {
 	PharmacyDrugID uint      `json:"pharmacy_drug_id"`
 	Quantity       uint      `json:"quantity"`
 	IsPrescripted  bool      `json:"is_prescripted"`
+	PrescriptionID *uint     `json:"prescription_id"`
 	Price          uint      `json:"price,omitempty"`
 	Stock          *uint     `json:"stock,omitempty"`
 	Drug           *DrugDto  `json:"drug"`
@@ -26,6 +27,11 @@ type CartItemsWithPharmacyDto struct {
 
 type CartItemsWithPharmacyDtos []CartItemsWithPharmacyDto
 
+type RedeemPrescriptionDto struct {
+	Items                []CartItemDto     `json:"items"`
+	ExpiredPrescriptions []PrescriptionDto `json:"expired_prescriptions"`
+}
+
 func NewCartItemDto(item entity.CartItem) CartItemDto {
 	return CartItemDto{
 		ID:             item.ID,
@@ -33,6 +39,7 @@ func NewCartItemDto(item entity.CartItem) CartItemDto {
 		PharmacyDrugID: item.PharmacyDrugID,
 		Quantity:       item.Quantity,
 		IsPrescripted:  item.IsPrescripted,
+		PrescriptionID: item.PrescriptionID,
 		CreatedAt:      item.CreatedAt,
 	}
 }
@@ -51,6 +58,7 @@ func NewCartItemsWithSummary(items []entity.CartItem) CartItemsWithPharmacyDtos
 			Price:          uint(item.PharmacyDrug.Price),
 			Stock:          &item.PharmacyDrug.Stock,
 			IsPrescripted:  item.IsPrescripted,
+			PrescriptionID: item.PrescriptionID,
 			Drug:           NewDrugDto(item.PharmacyDrug.Drug),
 			CreatedAt:      item.CreatedAt,
 		}
@@ -81,3 +89,20 @@ func (dtos CartItemsWithPharmacyDtos) ContainPharmacyID(id uint) int {
 
 	return -1
 }
+
+func NewRedeemPrescriptionDto(items []entity.CartItem, expired []entity.Prescription) RedeemPrescriptionDto {
+	return RedeemPrescriptionDto{
+		Items:                NewMultipleCartItemDto(items),
+		ExpiredPrescriptions: NewMultiplePrescriptionDto(expired),
+	}
+}
+
+func NewMultipleCartItemDto(items []entity.CartItem) []CartItemDto {
+	dtos := make([]CartItemDto, 0)
+
+	for _, item := range items {
+		dtos = append(dtos, NewCartItemDto(item))
+	}
+
+	return dtos
+}
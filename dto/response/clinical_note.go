@@ -0,0 +1,70 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type ICD10CodeDTO struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+type ClinicalNoteDiagnosisDTO struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	IsPrimary   bool   `json:"is_primary"`
+}
+
+type ClinicalNoteDTO struct {
+	ID             uint                       `json:"id"`
+	TelemedicineID uint                       `json:"telemedicine_id"`
+	Subjective     *string                    `json:"subjective"`
+	Objective      *string                    `json:"objective"`
+	Assessment     *string                    `json:"assessment"`
+	Plan           *string                    `json:"plan"`
+	Diagnoses      []ClinicalNoteDiagnosisDTO `json:"diagnoses"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	UpdatedAt      time.Time                  `json:"updated_at"`
+}
+
+func NewICD10CodeDTO(c entity.ICD10Code) ICD10CodeDTO {
+	return ICD10CodeDTO{
+		Code:        c.Code,
+		Description: c.Description,
+	}
+}
+
+func NewMultipleICD10CodeDTO(codes []entity.ICD10Code) []ICD10CodeDTO {
+	dtos := make([]ICD10CodeDTO, 0)
+
+	for _, code := range codes {
+		dtos = append(dtos, NewICD10CodeDTO(code))
+	}
+
+	return dtos
+}
+
+func NewClinicalNoteDTO(n entity.ClinicalNote) ClinicalNoteDTO {
+	diagnoses := make([]ClinicalNoteDiagnosisDTO, 0)
+	for _, diagnosis := range n.Diagnoses {
+		diagnoses = append(diagnoses, ClinicalNoteDiagnosisDTO{
+			Code:        diagnosis.ICD10Code.Code,
+			Description: diagnosis.ICD10Code.Description,
+			IsPrimary:   diagnosis.IsPrimary,
+		})
+	}
+
+	return ClinicalNoteDTO{
+		ID:             n.ID,
+		TelemedicineID: n.TelemedicineID,
+		Subjective:     n.Subjective,
+		Objective:      n.Objective,
+		Assessment:     n.Assessment,
+		Plan:           n.Plan,
+		Diagnoses:      diagnoses,
+		CreatedAt:      n.CreatedAt,
+		UpdatedAt:      n.UpdatedAt,
+	}
+}
@@ -0,0 +1,41 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type DependentDTO struct {
+	ID           uint      `json:"id"`
+	Name         string    `json:"name"`
+	DateOfBirth  string    `json:"date_of_birth"`
+	Age          int       `json:"age"`
+	Gender       string    `json:"gender"`
+	Relationship string    `json:"relationship"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func NewDependentDTO(d entity.Dependent) DependentDTO {
+	return DependentDTO{
+		ID:           d.ID,
+		Name:         d.Name,
+		DateOfBirth:  d.DateOfBirth.Format(constant.DateFormat),
+		Age:          utils.GetAge(d.DateOfBirth),
+		Gender:       d.Gender,
+		Relationship: d.Relationship,
+		CreatedAt:    d.CreatedAt,
+	}
+}
+
+func NewMultipleDependentDTO(dependents []entity.Dependent) []DependentDTO {
+	dtos := make([]DependentDTO, 0)
+
+	for _, dependent := range dependents {
+		dtos = append(dtos, NewDependentDTO(dependent))
+	}
+
+	return dtos
+}
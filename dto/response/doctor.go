@@ -21,6 +21,8 @@ type DoctorDto struct {
 	Price             uint               `json:"price"`
 	Status            string             `json:"status"`
 	YearsOfExperience uint               `json:"years_of_experience"`
+	RatingAverage     float64            `json:"rating_average"`
+	RatingCount       uint               `json:"rating_count"`
 	CreatedAt         time.Time          `json:"created_at"`
 	Specialization    *SpecializationDto `json:"specialization,omitempty"`
 }
@@ -46,6 +48,8 @@ func NewDoctorDto(doctor entity.Doctor) DoctorDto {
 		Price:             doctor.Price,
 		Status:            doctor.Status,
 		YearsOfExperience: doctor.YearsOfExperience,
+		RatingAverage:     doctor.RatingAverage,
+		RatingCount:       doctor.RatingCount,
 		CreatedAt:         doctor.CreatedAt,
 		Specialization:    specialization,
 	}
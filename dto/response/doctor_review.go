@@ -0,0 +1,45 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type DoctorReviewDTO struct {
+	ID             uint      `json:"id"`
+	TelemedicineID uint      `json:"telemedicine_id"`
+	DoctorID       uint      `json:"doctor_id"`
+	UserID         uint      `json:"user_id"`
+	UserName       string    `json:"user_name,omitempty"`
+	UserPhotoURL   string    `json:"user_photo_url,omitempty"`
+	Rating         int       `json:"rating"`
+	Review         *string   `json:"review"`
+	IsHidden       bool      `json:"is_hidden"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func NewDoctorReviewDTO(r entity.DoctorReview) DoctorReviewDTO {
+	return DoctorReviewDTO{
+		ID:             r.ID,
+		TelemedicineID: r.TelemedicineID,
+		DoctorID:       r.DoctorID,
+		UserID:         r.User.ID,
+		UserName:       r.User.Name,
+		UserPhotoURL:   r.User.PhotoURL,
+		Rating:         r.Rating,
+		Review:         r.Review,
+		IsHidden:       r.IsHidden,
+		CreatedAt:      r.CreatedAt,
+	}
+}
+
+func NewMultipleDoctorReviewDTO(reviews []entity.DoctorReview) []DoctorReviewDTO {
+	dtos := make([]DoctorReviewDTO, 0)
+
+	for _, review := range reviews {
+		dtos = append(dtos, NewDoctorReviewDTO(review))
+	}
+
+	return dtos
+}
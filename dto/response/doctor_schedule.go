@@ -0,0 +1,79 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type DoctorScheduleDTO struct {
+	ID           uint   `json:"id"`
+	DayOfWeek    int    `json:"day_of_week"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+	SlotDuration int    `json:"slot_duration"`
+}
+
+type DoctorScheduleExceptionDTO struct {
+	ID        uint    `json:"id"`
+	Date      string  `json:"date"`
+	StartTime *string `json:"start_time"`
+	EndTime   *string `json:"end_time"`
+	Reason    *string `json:"reason"`
+}
+
+type SlotDTO struct {
+	StartAt time.Time `json:"start_at"`
+	EndAt   time.Time `json:"end_at"`
+}
+
+func NewDoctorScheduleDTO(s entity.DoctorSchedule) DoctorScheduleDTO {
+	return DoctorScheduleDTO{
+		ID:           s.ID,
+		DayOfWeek:    s.DayOfWeek,
+		StartTime:    s.StartTime,
+		EndTime:      s.EndTime,
+		SlotDuration: s.SlotDuration,
+	}
+}
+
+func NewMultipleDoctorScheduleDTO(schedules []entity.DoctorSchedule) []DoctorScheduleDTO {
+	dtos := make([]DoctorScheduleDTO, 0)
+
+	for _, schedule := range schedules {
+		dtos = append(dtos, NewDoctorScheduleDTO(schedule))
+	}
+
+	return dtos
+}
+
+func NewDoctorScheduleExceptionDTO(e entity.DoctorScheduleException) DoctorScheduleExceptionDTO {
+	return DoctorScheduleExceptionDTO{
+		ID:        e.ID,
+		Date:      e.Date.Format(constant.DateFormat),
+		StartTime: e.StartTime,
+		EndTime:   e.EndTime,
+		Reason:    e.Reason,
+	}
+}
+
+func NewMultipleDoctorScheduleExceptionDTO(exceptions []entity.DoctorScheduleException) []DoctorScheduleExceptionDTO {
+	dtos := make([]DoctorScheduleExceptionDTO, 0)
+
+	for _, exception := range exceptions {
+		dtos = append(dtos, NewDoctorScheduleExceptionDTO(exception))
+	}
+
+	return dtos
+}
+
+func NewMultipleSlotDTO(slots []entity.Slot) []SlotDTO {
+	dtos := make([]SlotDTO, 0)
+
+	for _, slot := range slots {
+		dtos = append(dtos, SlotDTO{StartAt: slot.StartAt, EndAt: slot.EndAt})
+	}
+
+	return dtos
+}
@@ -0,0 +1,41 @@
+package response
+
+import "Alice-Seahat-Healthcare/seahat-be/entity"
+
+type DrugInteractionWarningDTO struct {
+	DrugAID     uint   `json:"drug_a_id"`
+	DrugAName   string `json:"drug_a_name"`
+	DrugBID     uint   `json:"drug_b_id"`
+	DrugBName   string `json:"drug_b_name"`
+	IngredientA string `json:"ingredient_a"`
+	IngredientB string `json:"ingredient_b"`
+	Severity    string `json:"severity"`
+	Description string `json:"description"`
+}
+
+type DrugInteractionImportDTO struct {
+	Imported int `json:"imported"`
+}
+
+func NewDrugInteractionWarningDTO(w entity.DrugInteractionWarning) DrugInteractionWarningDTO {
+	return DrugInteractionWarningDTO{
+		DrugAID:     w.DrugA.ID,
+		DrugAName:   w.DrugA.Name,
+		DrugBID:     w.DrugB.ID,
+		DrugBName:   w.DrugB.Name,
+		IngredientA: w.Interaction.IngredientA,
+		IngredientB: w.Interaction.IngredientB,
+		Severity:    w.Interaction.Severity,
+		Description: w.Interaction.Description,
+	}
+}
+
+func NewMultipleDrugInteractionWarningDTO(warnings []entity.DrugInteractionWarning) []DrugInteractionWarningDTO {
+	dtos := make([]DrugInteractionWarningDTO, 0)
+
+	for _, warning := range warnings {
+		dtos = append(dtos, NewDrugInteractionWarningDTO(warning))
+	}
+
+	return dtos
+}
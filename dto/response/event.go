@@ -0,0 +1,17 @@
+package response
+
+import (
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/libs/hub"
+)
+
+func NewEventDTO(event hub.Event) any {
+	switch data := event.Data.(type) {
+	case entity.MessageBubble:
+		return NewMessageBubbleDTO(data)
+	case entity.Telemedicine:
+		return NewTelemedicineDTO(data)
+	default:
+		return data
+	}
+}
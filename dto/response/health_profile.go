@@ -0,0 +1,129 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type AllergyDTO struct {
+	ID       uint    `json:"id"`
+	Allergen string  `json:"allergen"`
+	Reaction *string `json:"reaction"`
+	Severity string  `json:"severity"`
+}
+
+type ChronicConditionDTO struct {
+	ID        uint    `json:"id"`
+	Name      string  `json:"name"`
+	ICD10Code *string `json:"icd10_code"`
+	Notes     *string `json:"notes"`
+}
+
+type MedicationDTO struct {
+	ID        uint    `json:"id"`
+	Name      string  `json:"name"`
+	DrugID    *uint   `json:"drug_id"`
+	Dosage    *string `json:"dosage"`
+	StartedAt *string `json:"started_at"`
+}
+
+type HealthProfileDTO struct {
+	UserID            uint                  `json:"user_id"`
+	WeightKg          *float64              `json:"weight_kg"`
+	HeightCm          *float64              `json:"height_cm"`
+	IsPregnant        *bool                 `json:"is_pregnant"`
+	Allergies         []AllergyDTO          `json:"allergies"`
+	ChronicConditions []ChronicConditionDTO `json:"chronic_conditions"`
+	Medications       []MedicationDTO       `json:"medications"`
+	UpdatedAt         *time.Time            `json:"updated_at"`
+}
+
+type AllergyWarningDTO struct {
+	PrescriptionID uint   `json:"prescription_id"`
+	DrugID         uint   `json:"drug_id"`
+	DrugName       string `json:"drug_name"`
+	Allergen       string `json:"allergen"`
+	Severity       string `json:"severity"`
+}
+
+type PrescriptionResultDTO struct {
+	PrescriptionURL     string                      `json:"prescription_url"`
+	AllergyWarnings     []AllergyWarningDTO         `json:"allergy_warnings"`
+	InteractionWarnings []DrugInteractionWarningDTO `json:"interaction_warnings"`
+}
+
+func NewHealthProfileDTO(p entity.HealthProfile) HealthProfileDTO {
+	allergies := make([]AllergyDTO, 0)
+	for _, allergy := range p.Allergies {
+		allergies = append(allergies, AllergyDTO{
+			ID:       allergy.ID,
+			Allergen: allergy.Allergen,
+			Reaction: allergy.Reaction,
+			Severity: allergy.Severity,
+		})
+	}
+
+	conditions := make([]ChronicConditionDTO, 0)
+	for _, condition := range p.ChronicConditions {
+		conditions = append(conditions, ChronicConditionDTO{
+			ID:        condition.ID,
+			Name:      condition.Name,
+			ICD10Code: condition.ICD10Code,
+			Notes:     condition.Notes,
+		})
+	}
+
+	medications := make([]MedicationDTO, 0)
+	for _, medication := range p.Medications {
+		var startedAt *string
+		if medication.StartedAt != nil {
+			date := medication.StartedAt.Format(constant.DateFormat)
+			startedAt = &date
+		}
+
+		medications = append(medications, MedicationDTO{
+			ID:        medication.ID,
+			Name:      medication.Name,
+			DrugID:    medication.DrugID,
+			Dosage:    medication.Dosage,
+			StartedAt: startedAt,
+		})
+	}
+
+	var updatedAt *time.Time
+	if !p.UpdatedAt.IsZero() {
+		updatedAt = &p.UpdatedAt
+	}
+
+	return HealthProfileDTO{
+		UserID:            p.UserID,
+		WeightKg:          p.WeightKg,
+		HeightCm:          p.HeightCm,
+		IsPregnant:        p.IsPregnant,
+		Allergies:         allergies,
+		ChronicConditions: conditions,
+		Medications:       medications,
+		UpdatedAt:         updatedAt,
+	}
+}
+
+func NewPrescriptionResultDTO(url string, warnings entity.PrescriptionWarnings) PrescriptionResultDTO {
+	warningDtos := make([]AllergyWarningDTO, 0)
+	for _, warning := range warnings.Allergies {
+		warningDtos = append(warningDtos, AllergyWarningDTO{
+			PrescriptionID: warning.PrescriptionID,
+			DrugID:         warning.DrugID,
+			DrugName:       warning.DrugName,
+			Allergen:       warning.Allergen,
+			Severity:       warning.Severity,
+		})
+	}
+
+	return PrescriptionResultDTO{
+		PrescriptionURL:     url,
+		AllergyWarnings:     warningDtos,
+		InteractionWarnings: NewMultipleDrugInteractionWarningDTO(warnings.Interactions),
+	}
+}
@@ -0,0 +1,40 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type MedicalDocumentVerificationDTO struct {
+	Code                 string     `json:"code"`
+	Type                 string     `json:"type"`
+	Status               string     `json:"status"`
+	IsAuthentic          bool       `json:"is_authentic"`
+	DoctorName           string     `json:"doctor_name"`
+	DoctorSpecialization string     `json:"doctor_specialization"`
+	PatientInitials      string     `json:"patient_initials"`
+	IssuedAt             time.Time  `json:"issued_at"`
+	ValidUntil           *time.Time `json:"valid_until"`
+	SupersededAt         *time.Time `json:"superseded_at"`
+	DocumentHash         string     `json:"document_hash"`
+	Signature            string     `json:"signature"`
+}
+
+func NewMedicalDocumentVerificationDTO(d entity.MedicalDocument) MedicalDocumentVerificationDTO {
+	return MedicalDocumentVerificationDTO{
+		Code:                 d.Code,
+		Type:                 d.Type,
+		Status:               d.Status,
+		IsAuthentic:          d.IsAuthentic,
+		DoctorName:           d.Telemedicine.Doctor.Name,
+		DoctorSpecialization: d.Telemedicine.Doctor.Specialization.Name,
+		PatientInitials:      utils.NameInitials(d.Telemedicine.User.Name),
+		IssuedAt:             d.IssuedAt,
+		ValidUntil:           d.ValidUntil,
+		SupersededAt:         d.SupersededAt,
+		DocumentHash:         d.Hash,
+		Signature:            d.Signature,
+	}
+}
@@ -7,21 +7,50 @@ import (
 )
 
 type MessageBubbleDTO struct {
-	ID             uint      `json:"id"`
-	TelemedicineID uint      `json:"telemedicine_id"`
-	SenderType     string    `json:"sender_type"`
-	Payload        string    `json:"payload"`
-	PayloadType    string    `json:"payload_type"`
-	CreatedAt      time.Time `json:"created_at"`
+	ID             uint                  `json:"id"`
+	TelemedicineID uint                  `json:"telemedicine_id"`
+	SenderType     string                `json:"sender_type"`
+	Payload        string                `json:"payload"`
+	PayloadType    string                `json:"payload_type"`
+	Attachment     *MessageAttachmentDTO `json:"attachment,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+}
+
+type MessageAttachmentDTO struct {
+	ID           uint   `json:"id"`
+	MimeType     string `json:"mime_type"`
+	Size         int64  `json:"size"`
+	OriginalName string `json:"original_name"`
 }
 
 func NewMessageBubbleDTO(p entity.MessageBubble) MessageBubbleDTO {
+	var attachment *MessageAttachmentDTO
+	if p.Attachment != nil {
+		attachment = &MessageAttachmentDTO{
+			ID:           p.Attachment.ID,
+			MimeType:     p.Attachment.MimeType,
+			Size:         p.Attachment.Size,
+			OriginalName: p.Attachment.OriginalName,
+		}
+	}
+
 	return MessageBubbleDTO{
 		ID:             p.ID,
 		TelemedicineID: p.TelemedicineID,
 		SenderType:     p.SenderType,
 		Payload:        p.Payload,
 		PayloadType:    p.PayloadType,
+		Attachment:     attachment,
 		CreatedAt:      p.CreatedAt,
 	}
 }
+
+func NewMultipleMessageBubbleDTO(mbs []entity.MessageBubble) []MessageBubbleDTO {
+	dtos := make([]MessageBubbleDTO, 0)
+
+	for _, mb := range mbs {
+		dtos = append(dtos, NewMessageBubbleDTO(mb))
+	}
+
+	return dtos
+}
@@ -14,6 +14,7 @@ type OrderDTO struct {
 	TotalPrice     int               `json:"total_price"`
 	FinishedAt     *sql.NullTime     `json:"finished_at,omitempty"`
 	Status         string            `json:"status"`
+	DisputeReason  *string           `json:"dispute_reason,omitempty"`
 	ShipmentMethod ShipmentMethodDto `json:"shipment_method"`
 	Detail         []*OrderDetailDTO `json:"order_detail,omitempty"`
 }
@@ -46,6 +47,7 @@ func NewOrderDto(order entity.Order) *OrderDTO {
 		TotalPrice:     order.TotalPrice,
 		FinishedAt:     order.FinishedAt,
 		Status:         order.Status,
+		DisputeReason:  order.DisputeReason,
 		ShipmentMethod: shipment,
 		Detail:         orderDetails,
 	}
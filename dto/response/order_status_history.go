@@ -0,0 +1,39 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type OrderStatusHistoryDTO struct {
+	ID         uint      `json:"id"`
+	FromStatus *string   `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorRole  string    `json:"actor_role"`
+	ActorID    *uint     `json:"actor_id"`
+	Reason     *string   `json:"reason"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func NewOrderStatusHistoryDTO(h entity.OrderStatusHistory) OrderStatusHistoryDTO {
+	return OrderStatusHistoryDTO{
+		ID:         h.ID,
+		FromStatus: h.FromStatus,
+		ToStatus:   h.ToStatus,
+		ActorRole:  h.ActorRole,
+		ActorID:    h.ActorID,
+		Reason:     h.Reason,
+		CreatedAt:  h.CreatedAt,
+	}
+}
+
+func NewMultipleOrderStatusHistoryDTO(histories []entity.OrderStatusHistory) []OrderStatusHistoryDTO {
+	dtos := make([]OrderStatusHistoryDTO, 0)
+
+	for _, history := range histories {
+		dtos = append(dtos, NewOrderStatusHistoryDTO(history))
+	}
+
+	return dtos
+}
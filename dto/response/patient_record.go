@@ -0,0 +1,66 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type PatientRecordConsultationDTO struct {
+	ID                    uint              `json:"id"`
+	DoctorID              uint              `json:"doctor_id"`
+	DoctorName            string            `json:"doctor_name"`
+	DoctorSpecialization  string            `json:"doctor_specialization"`
+	StartAt               *time.Time        `json:"start_at"`
+	EndAt                 *time.Time        `json:"end_at"`
+	StartRestAt           *time.Time        `json:"start_rest_at"`
+	RestDuration          *int              `json:"rest_duration"`
+	MedicalCertificateURL *string           `json:"medical_certificate_url"`
+	PrescriptionURL       *string           `json:"prescription_url"`
+	ClinicalNote          *ClinicalNoteDTO  `json:"clinical_note"`
+	Prescriptions         []PrescriptionDto `json:"prescriptions"`
+}
+
+type PatientRecordDTO struct {
+	Patient       UserDto                        `json:"patient"`
+	Dependent     *DependentDTO                  `json:"dependent"`
+	Consultations []PatientRecordConsultationDTO `json:"consultations"`
+}
+
+func NewPatientRecordDTO(r entity.PatientRecord) PatientRecordDTO {
+	consultations := make([]PatientRecordConsultationDTO, 0)
+	for _, t := range r.Telemedicines {
+		var noteDto *ClinicalNoteDTO
+		if t.ClinicalNote != nil {
+			note := NewClinicalNoteDTO(*t.ClinicalNote)
+			noteDto = &note
+		}
+
+		consultations = append(consultations, PatientRecordConsultationDTO{
+			ID:                    t.ID,
+			DoctorID:              t.Doctor.ID,
+			DoctorName:            t.Doctor.Name,
+			DoctorSpecialization:  t.Doctor.Specialization.Name,
+			StartAt:               t.StartAt,
+			EndAt:                 t.EndAt,
+			StartRestAt:           t.StartRestAt,
+			RestDuration:          t.RestDuration,
+			MedicalCertificateURL: t.MedicalCertificateURL,
+			PrescriptionURL:       t.PrescriptionUrl,
+			ClinicalNote:          noteDto,
+			Prescriptions:         NewMultiplePrescriptionDto(t.Prescriptions),
+		})
+	}
+
+	var dependentDto *DependentDTO
+	if r.Dependent != nil {
+		dependent := NewDependentDTO(*r.Dependent)
+		dependentDto = &dependent
+	}
+
+	return PatientRecordDTO{
+		Patient:       NewUserDto(r.User),
+		Dependent:     dependentDto,
+		Consultations: consultations,
+	}
+}
@@ -5,16 +5,17 @@ import (
 )
 
 type PaymentDTO struct {
-	Id              uint        `json:"payment_id"`
-	UserId          uint        `json:"user_id"`
-	UserName        string      `json:"user_name"`
-	Method          string      `json:"payment_method"`
-	Proof           *string     `json:"payment_proof"`
-	FullUserAddress string      `json:"full_user_address,omitempty"`
-	TotalPrice      int         `json:"total_price"`
-	Number          string      `json:"payment_number"`
-	Status          string      `json:"payment_status"`
-	Orders          []*OrderDTO `json:"orders,omitempty"`
+	Id                  uint                        `json:"payment_id"`
+	UserId              uint                        `json:"user_id"`
+	UserName            string                      `json:"user_name"`
+	Method              string                      `json:"payment_method"`
+	Proof               *string                     `json:"payment_proof"`
+	FullUserAddress     string                      `json:"full_user_address,omitempty"`
+	TotalPrice          int                         `json:"total_price"`
+	Number              string                      `json:"payment_number"`
+	Status              string                      `json:"payment_status"`
+	Orders              []*OrderDTO                 `json:"orders,omitempty"`
+	InteractionWarnings []DrugInteractionWarningDTO `json:"interaction_warnings,omitempty"`
 }
 type GetPaymentDTO struct {
 	Id              uint           `json:"payment_id"`
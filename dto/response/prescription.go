@@ -3,17 +3,37 @@ package response
 import (
 	"time"
 
+	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 )
 
 type PrescriptionDto struct {
-	ID             uint      `json:"id"`
-	TelemedicineID uint      `json:"telemedicine_id"`
-	DrugID         uint      `json:"drug_id"`
-	Quantity       uint      `json:"quantity"`
-	Notes          string    `json:"notes"`
-	CreatedAt      time.Time `json:"created_at"`
-	Drug           *DrugDto  `json:"drug,omitempty"`
+	ID               uint                  `json:"id"`
+	TelemedicineID   uint                  `json:"telemedicine_id"`
+	DrugID           uint                  `json:"drug_id"`
+	Quantity         uint                  `json:"quantity"`
+	RedeemedQuantity uint                  `json:"redeemed_quantity"`
+	Notes            string                `json:"notes"`
+	Frequency        *string               `json:"frequency"`
+	DurationDays     *uint                 `json:"duration_days"`
+	Route            *string               `json:"route"`
+	FillStatus       string                `json:"fill_status"`
+	IssuedAt         time.Time             `json:"issued_at"`
+	ExpiredAt        time.Time             `json:"expired_at"`
+	CreatedAt        time.Time             `json:"created_at"`
+	Drug             *DrugDto              `json:"drug,omitempty"`
+	Fills            []PrescriptionFillDto `json:"fills,omitempty"`
+}
+
+type PrescriptionFillDto struct {
+	ID             uint             `json:"id"`
+	PrescriptionID uint             `json:"prescription_id"`
+	OrderDetailID  uint             `json:"order_detail_id"`
+	OrderID        uint             `json:"order_id"`
+	OrderStatus    string           `json:"order_status"`
+	Quantity       uint             `json:"quantity"`
+	CreatedAt      time.Time        `json:"created_at"`
+	Prescription   *PrescriptionDto `json:"prescription,omitempty"`
 }
 
 func NewPrescriptionDto(p entity.Prescription) PrescriptionDto {
@@ -22,14 +42,27 @@ func NewPrescriptionDto(p entity.Prescription) PrescriptionDto {
 		drugDto = NewDrugDto(p.Drug)
 	}
 
+	var fills []PrescriptionFillDto
+	if len(p.Fills) > 0 {
+		fills = NewMultiplePrescriptionFillDto(p.Fills)
+	}
+
 	return PrescriptionDto{
-		ID:             p.ID,
-		TelemedicineID: p.TelemedicineID,
-		DrugID:         p.DrugID,
-		Quantity:       p.Quantity,
-		Notes:          p.Notes,
-		CreatedAt:      p.CreatedAt,
-		Drug:           drugDto,
+		ID:               p.ID,
+		TelemedicineID:   p.TelemedicineID,
+		DrugID:           p.DrugID,
+		Quantity:         p.Quantity,
+		RedeemedQuantity: p.RedeemedQuantity,
+		Notes:            p.Notes,
+		Frequency:        p.Frequency,
+		DurationDays:     p.DurationDays,
+		Route:            p.Route,
+		FillStatus:       prescriptionFillStatus(p),
+		IssuedAt:         p.IssuedAt,
+		ExpiredAt:        p.ExpiredAt,
+		CreatedAt:        p.CreatedAt,
+		Drug:             drugDto,
+		Fills:            fills,
 	}
 }
 
@@ -42,3 +75,48 @@ func NewMultiplePrescriptionDto(ps []entity.Prescription) []PrescriptionDto {
 
 	return dtos
 }
+
+func NewPrescriptionFillDto(f entity.PrescriptionFill) PrescriptionFillDto {
+	var prescriptionDto *PrescriptionDto
+	if f.Prescription.ID != 0 {
+		dto := NewPrescriptionDto(f.Prescription)
+		prescriptionDto = &dto
+	}
+
+	return PrescriptionFillDto{
+		ID:             f.ID,
+		PrescriptionID: f.PrescriptionID,
+		OrderDetailID:  f.OrderDetailID,
+		OrderID:        f.OrderID,
+		OrderStatus:    f.OrderStatus,
+		Quantity:       f.Quantity,
+		CreatedAt:      f.CreatedAt,
+		Prescription:   prescriptionDto,
+	}
+}
+
+func NewMultiplePrescriptionFillDto(fs []entity.PrescriptionFill) []PrescriptionFillDto {
+	dtos := make([]PrescriptionFillDto, 0)
+
+	for _, f := range fs {
+		dtos = append(dtos, NewPrescriptionFillDto(f))
+	}
+
+	return dtos
+}
+
+func prescriptionFillStatus(p entity.Prescription) string {
+	if p.RedeemedQuantity >= p.Quantity {
+		return constant.PrescriptionFilled
+	}
+
+	if time.Now().After(p.ExpiredAt) {
+		return constant.PrescriptionExpired
+	}
+
+	if p.RedeemedQuantity > 0 {
+		return constant.PrescriptionPartiallyFilled
+	}
+
+	return constant.PrescriptionUnfilled
+}
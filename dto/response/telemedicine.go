@@ -9,15 +9,21 @@ import (
 type TelemedicineDTO struct {
 	ID                    uint              `json:"id"`
 	UserID                uint              `json:"user_id"`
+	DependentID           *uint             `json:"dependent_id"`
 	DoctorID              uint              `json:"doctor_id"`
 	EndAt                 *time.Time        `json:"end_at"`
-	Diagnose              *string           `json:"diagnose"`
+	SessionStatus         string            `json:"session_status"`
 	Price                 int               `json:"price"`
+	PaymentID             *uint             `json:"payment_id"`
+	Status                string            `json:"status"`
+	StartAt               *time.Time        `json:"start_at"`
+	AdmittedAt            *time.Time        `json:"admitted_at"`
 	StartRestAt           *time.Time        `json:"start_rest_at"`
 	RestDuration          *int              `json:"rest_duration"`
 	MedicalCertificateURL *string           `json:"medical_certificate_url"`
 	PrescriptionUrl       *string           `json:"prescription_url"`
 	Prescriptions         []PrescriptionDto `json:"prescriptions,omitempty"`
+	ClinicalNote          *ClinicalNoteDTO  `json:"clinical_note,omitempty"`
 	CreatedAt             time.Time         `json:"created_at"`
 }
 
@@ -27,34 +33,53 @@ func NewTelemedicineDTO(p entity.Telemedicine) TelemedicineDTO {
 		pDto = NewMultiplePrescriptionDto(p.Prescriptions)
 	}
 
+	var noteDto *ClinicalNoteDTO
+	if p.ClinicalNote != nil {
+		note := NewClinicalNoteDTO(*p.ClinicalNote)
+		noteDto = &note
+	}
+
 	return TelemedicineDTO{
 		ID:                    p.ID,
 		UserID:                p.User.ID,
+		DependentID:           p.DependentID,
 		DoctorID:              p.Doctor.ID,
 		EndAt:                 p.EndAt,
-		Diagnose:              p.Diagnose,
+		SessionStatus:         p.SessionStatus,
 		Price:                 p.Price,
+		PaymentID:             p.PaymentID,
+		Status:                p.Status,
+		StartAt:               p.StartAt,
+		AdmittedAt:            p.AdmittedAt,
 		StartRestAt:           p.StartRestAt,
 		RestDuration:          p.RestDuration,
 		MedicalCertificateURL: p.MedicalCertificateURL,
 		CreatedAt:             p.CreatedAt,
 		PrescriptionUrl:       p.PrescriptionUrl,
 		Prescriptions:         pDto,
+		ClinicalNote:          noteDto,
 	}
 }
 
 type UserDoctorTelemedicineDTO struct {
 	ID                    uint              `json:"id"`
 	User                  *UserDto          `json:"user"`
+	Dependent             *DependentDTO     `json:"dependent"`
 	Doctor                *DoctorDto        `json:"doctor"`
 	EndAt                 *time.Time        `json:"end_at"`
-	Diagnose              *string           `json:"diagnose"`
+	SessionStatus         string            `json:"session_status"`
 	Price                 int               `json:"price"`
+	PaymentID             *uint             `json:"payment_id"`
+	Status                string            `json:"status"`
+	StartAt               *time.Time        `json:"start_at"`
+	AdmittedAt            *time.Time        `json:"admitted_at"`
 	StartRestAt           *time.Time        `json:"start_rest_at"`
 	RestDuration          *int              `json:"rest_duration"`
 	MedicalCertificateURL *string           `json:"medical_certificate_url"`
 	PrescriptionURL       *string           `json:"prescription_url"`
 	Prescriptions         []PrescriptionDto `json:"prescriptions"`
+	ClinicalNote          *ClinicalNoteDTO  `json:"clinical_note"`
+	HealthProfile         *HealthProfileDTO `json:"health_profile,omitempty"`
 	CreatedAt             time.Time         `json:"created_at"`
 }
 
@@ -65,20 +90,45 @@ func NewUserDoctorTelemedicineDTO(p entity.Telemedicine) UserDoctorTelemedicineD
 		pDto = NewMultiplePrescriptionDto(p.Prescriptions)
 	}
 
+	var noteDto *ClinicalNoteDTO
+	if p.ClinicalNote != nil {
+		note := NewClinicalNoteDTO(*p.ClinicalNote)
+		noteDto = &note
+	}
+
+	var profileDto *HealthProfileDTO
+	if p.HealthProfile != nil {
+		profile := NewHealthProfileDTO(*p.HealthProfile)
+		profileDto = &profile
+	}
+
+	var dependentDto *DependentDTO
+	if p.Dependent != nil {
+		dependent := NewDependentDTO(*p.Dependent)
+		dependentDto = &dependent
+	}
+
 	doctor := NewDoctorDto(p.Doctor)
 	user := NewUserDto(p.User)
 	return UserDoctorTelemedicineDTO{
 		ID:                    p.ID,
 		User:                  &user,
+		Dependent:             dependentDto,
 		Doctor:                &doctor,
 		EndAt:                 p.EndAt,
-		Diagnose:              p.Diagnose,
+		SessionStatus:         p.SessionStatus,
 		Price:                 p.Price,
+		PaymentID:             p.PaymentID,
+		Status:                p.Status,
+		StartAt:               p.StartAt,
+		AdmittedAt:            p.AdmittedAt,
 		StartRestAt:           p.StartRestAt,
 		RestDuration:          p.RestDuration,
 		MedicalCertificateURL: p.MedicalCertificateURL,
 		PrescriptionURL:       p.PrescriptionUrl,
 		CreatedAt:             p.CreatedAt,
 		Prescriptions:         pDto,
+		ClinicalNote:          noteDto,
+		HealthProfile:         profileDto,
 	}
 }
@@ -0,0 +1,24 @@
+package response
+
+import (
+	"math"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type TelemedicineQueueDTO struct {
+	TelemedicineID       uint       `json:"telemedicine_id"`
+	Position             int        `json:"position"`
+	EstimatedWaitMinutes int        `json:"estimated_wait_minutes"`
+	AdmittedAt           *time.Time `json:"admitted_at"`
+}
+
+func NewTelemedicineQueueDTO(q entity.TelemedicineQueue) TelemedicineQueueDTO {
+	return TelemedicineQueueDTO{
+		TelemedicineID:       q.TelemedicineID,
+		Position:             q.Position,
+		EstimatedWaitMinutes: int(math.Ceil(q.EstimatedWait.Minutes())),
+		AdmittedAt:           q.AdmittedAt,
+	}
+}
@@ -0,0 +1,136 @@
+package response
+
+import (
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type UserOrderDTO struct {
+	Id             uint              `json:"order_id"`
+	OrderNumber    string            `json:"order_number"`
+	Status         string            `json:"status"`
+	Pharmacy       GetPharmacy       `json:"pharmacy"`
+	ShipmentMethod ShipmentMethodDto `json:"shipment_method"`
+	TotalPrice     int               `json:"total_price"`
+	PaymentId      uint              `json:"payment_id"`
+	PaymentNumber  string            `json:"payment_number"`
+	PaymentMethod  string            `json:"payment_method"`
+	FinishedAt     *time.Time        `json:"finished_at"`
+	CreatedAt      *time.Time        `json:"created_at"`
+}
+
+type UserOrderItemDTO struct {
+	Id             uint   `json:"order_detail_id"`
+	PharmacyDrugId uint   `json:"pharmacy_drug_id"`
+	DrugId         uint   `json:"drug_id"`
+	DrugName       string `json:"drug_name"`
+	ImageURL       string `json:"image_url"`
+	Quantity       uint   `json:"quantity"`
+	Price          uint   `json:"price"`
+	Subtotal       uint   `json:"subtotal"`
+}
+
+type OrderCostDTO struct {
+	Subtotal      uint `json:"subtotal"`
+	ShipmentPrice uint `json:"shipment_price"`
+	TotalPrice    int  `json:"total_price"`
+}
+
+type UserOrderDetailDTO struct {
+	UserOrderDTO
+	FullUserAddress string                  `json:"full_user_address"`
+	DisputedAt      *time.Time              `json:"disputed_at,omitempty"`
+	DisputeReason   *string                 `json:"dispute_reason,omitempty"`
+	Items           []UserOrderItemDTO      `json:"items"`
+	Cost            OrderCostDTO            `json:"cost"`
+	Timeline        []OrderStatusHistoryDTO `json:"timeline"`
+}
+
+func NewUserOrderDto(order entity.Order) UserOrderDTO {
+	dto := UserOrderDTO{
+		Id:             order.Id,
+		OrderNumber:    order.OrderNumber,
+		Status:         order.Status,
+		ShipmentMethod: NewShipmentMethodDto(order.ShipmentMethod),
+		TotalPrice:     order.TotalPrice,
+	}
+
+	if order.Pharmacy != nil {
+		dto.Pharmacy = GetPharmacy{ID: order.Pharmacy.ID, Name: order.Pharmacy.Name}
+	}
+
+	if order.Payment != nil {
+		dto.PaymentId = order.Payment.Id
+		dto.PaymentNumber = order.Payment.Number
+		dto.PaymentMethod = order.Payment.Method
+	}
+
+	if order.FinishedAt != nil && order.FinishedAt.Valid {
+		dto.FinishedAt = &order.FinishedAt.Time
+	}
+
+	if order.CreatedAt != nil && order.CreatedAt.Valid {
+		dto.CreatedAt = &order.CreatedAt.Time
+	}
+
+	return dto
+}
+
+func NewMultipleUserOrderDto(orders []*entity.Order) []UserOrderDTO {
+	dtos := make([]UserOrderDTO, 0)
+
+	for _, order := range orders {
+		dtos = append(dtos, NewUserOrderDto(*order))
+	}
+
+	return dtos
+}
+
+func NewUserOrderDetailDto(order entity.Order) UserOrderDetailDTO {
+	items := make([]UserOrderItemDTO, 0)
+	var subtotal uint
+
+	for _, detail := range order.Detail {
+		itemSubtotal := detail.Price * detail.Quantity
+		subtotal += itemSubtotal
+
+		items = append(items, UserOrderItemDTO{
+			Id:             detail.Id,
+			PharmacyDrugId: detail.PharmacyDrugId,
+			DrugId:         detail.PharmacyDrug.DrugID,
+			DrugName:       detail.PharmacyDrug.Drug.Name,
+			ImageURL:       detail.PharmacyDrug.Drug.ImageURL,
+			Quantity:       detail.Quantity,
+			Price:          detail.Price,
+			Subtotal:       itemSubtotal,
+		})
+	}
+
+	var shipmentPrice uint
+	if order.ShipmentMethod.Price != nil {
+		shipmentPrice = *order.ShipmentMethod.Price
+	}
+
+	dto := UserOrderDetailDTO{
+		UserOrderDTO:  NewUserOrderDto(order),
+		DisputeReason: order.DisputeReason,
+		Items:         items,
+		Cost: OrderCostDTO{
+			Subtotal:      subtotal,
+			ShipmentPrice: shipmentPrice,
+			TotalPrice:    order.TotalPrice,
+		},
+		Timeline: NewMultipleOrderStatusHistoryDTO(order.StatusHistories),
+	}
+
+	if order.Payment != nil {
+		dto.FullUserAddress = order.Payment.FullUserAddress
+	}
+
+	if order.DisputedAt != nil && order.DisputedAt.Valid {
+		dto.DisputedAt = &order.DisputedAt.Time
+	}
+
+	return dto
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type Appointment struct {
+	ID             uint
+	DoctorID       uint
+	UserID         uint
+	TelemedicineID uint
+	StartAt        time.Time
+	EndAt          time.Time
+	Status         string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -8,6 +8,7 @@ type CartItem struct {
 	PharmacyDrugID uint
 	Quantity       uint
 	IsPrescripted  bool
+	PrescriptionID *uint
 	Price          uint
 	TotalPrice     uint
 	PharmacyDrug   PharmacyDrug
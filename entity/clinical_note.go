@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+type ICD10Code struct {
+	Code        string
+	Description string
+}
+
+type ClinicalNoteDiagnosis struct {
+	ICD10Code ICD10Code
+	IsPrimary bool
+}
+
+type ClinicalNote struct {
+	ID             uint
+	TelemedicineID uint
+	Subjective     *string
+	Objective      *string
+	Assessment     *string
+	Plan           *string
+	Diagnoses      []ClinicalNoteDiagnosis
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
@@ -0,0 +1,7 @@
+package entity
+
+type Cursor struct {
+	Before uint
+	After  uint
+	Limit  uint
+}
@@ -0,0 +1,15 @@
+package entity
+
+import "time"
+
+type Dependent struct {
+	ID           uint
+	UserID       uint
+	Name         string
+	DateOfBirth  time.Time
+	Gender       string
+	Relationship string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+}
@@ -18,6 +18,8 @@ type Doctor struct {
 	Price             uint
 	Status            string
 	YearsOfExperience uint
+	RatingAverage     float64
+	RatingCount       uint
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 	DeletedAt         *time.Time
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type DoctorReview struct {
+	ID             uint
+	TelemedicineID uint
+	DoctorID       uint
+	User           User
+	Rating         int
+	Review         *string
+	IsHidden       bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
@@ -0,0 +1,32 @@
+package entity
+
+import "time"
+
+type DoctorSchedule struct {
+	ID           uint
+	DoctorID     uint
+	DayOfWeek    int
+	StartTime    string
+	EndTime      string
+	SlotDuration int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+}
+
+type DoctorScheduleException struct {
+	ID        uint
+	DoctorID  uint
+	Date      time.Time
+	StartTime *string
+	EndTime   *string
+	Reason    *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt *time.Time
+}
+
+type Slot struct {
+	StartAt time.Time
+	EndAt   time.Time
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type DrugInteraction struct {
+	ID          uint
+	IngredientA string
+	IngredientB string
+	Severity    string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+type DrugInteractionWarning struct {
+	Interaction DrugInteraction
+	DrugA       Drug
+	DrugB       Drug
+}
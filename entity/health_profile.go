@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+type Allergy struct {
+	ID       uint
+	Allergen string
+	Reaction *string
+	Severity string
+}
+
+type ChronicCondition struct {
+	ID        uint
+	Name      string
+	ICD10Code *string
+	Notes     *string
+}
+
+type Medication struct {
+	ID        uint
+	Name      string
+	DrugID    *uint
+	Dosage    *string
+	StartedAt *time.Time
+}
+
+type HealthProfile struct {
+	ID                uint
+	UserID            uint
+	WeightKg          *float64
+	HeightCm          *float64
+	IsPregnant        *bool
+	Allergies         []Allergy
+	ChronicConditions []ChronicCondition
+	Medications       []Medication
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         *time.Time
+}
+
+type AllergyWarning struct {
+	PrescriptionID uint
+	DrugID         uint
+	DrugName       string
+	Allergen       string
+	Severity       string
+}
@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+type MedicalDocument struct {
+	ID           uint
+	Code         string
+	Type         string
+	Telemedicine Telemedicine
+	Hash         string
+	Signature    string
+	IssuedAt     time.Time
+	ValidUntil   *time.Time
+	SupersededAt *time.Time
+	IsAuthentic  bool
+	Status       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
+}
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type MessageAttachment struct {
+	ID              uint
+	MessageBubbleID uint
+	MimeType        string
+	Size            int64
+	OriginalName    string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	DeletedAt       *time.Time
+}
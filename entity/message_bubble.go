@@ -8,6 +8,7 @@ type MessageBubble struct {
 	SenderType     string
 	Payload        string
 	PayloadType    string
+	Attachment     *MessageAttachment
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 	DeletedAt      *time.Time
@@ -5,16 +5,20 @@ import (
 )
 
 type Order struct {
-	Id             uint
-	Payment        *Payment
-	PharmacyId     uint
-	Pharmacy       *Pharmacy
-	OrderNumber    string
-	TotalPrice     int
-	FinishedAt     *sql.NullTime
-	Status         string
-	ShipmentMethod ShipmentMethod
-	Cart           []*CartItem
-	Detail         []*OrderDetail
-	CreatedAt      *sql.NullTime
+	Id                uint
+	Payment           *Payment
+	PharmacyId        uint
+	Pharmacy          *Pharmacy
+	OrderNumber       string
+	TotalPrice        int
+	FinishedAt        *sql.NullTime
+	DisputedAt        *sql.NullTime
+	DisputeReason     *string
+	Status            string
+	ShipmentMethod    ShipmentMethod
+	Cart              []*CartItem
+	Detail            []*OrderDetail
+	PrescriptionFills []PrescriptionFill
+	StatusHistories   []OrderStatusHistory
+	CreatedAt         *sql.NullTime
 }
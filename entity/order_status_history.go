@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type OrderStatusHistory struct {
+	ID         uint
+	OrderID    uint
+	FromStatus *string
+	ToStatus   string
+	ActorRole  string
+	ActorID    *uint
+	Reason     *string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  *time.Time
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+type PatientRecord struct {
+	User          User
+	Dependent     *Dependent
+	Telemedicines []Telemedicine
+}
+
+type PatientRecordAccessLog struct {
+	ID             uint
+	DoctorID       uint
+	UserID         uint
+	TelemedicineID uint
+	IPAddress      string
+	UserAgent      string
+	AccessedAt     time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
@@ -17,6 +17,8 @@ type Payment struct {
 	Number          string
 	Status          string
 	ExpiredAt       *sql.NullTime
+	IsExpired       bool
+	RefundedAt      *sql.NullTime
 	Orders          []*Order
 	CreatedAt       *sql.NullTime
 	UpdatedAt       time.Time
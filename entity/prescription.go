@@ -3,13 +3,25 @@ package entity
 import "time"
 
 type Prescription struct {
-	ID             uint
-	TelemedicineID uint
-	DrugID         uint
-	Quantity       uint
-	Notes          string
-	Drug           Drug
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	DeletedAt      *time.Time
+	ID               uint
+	TelemedicineID   uint
+	DrugID           uint
+	Quantity         uint
+	RedeemedQuantity uint
+	Notes            string
+	Frequency        *string
+	DurationDays     *uint
+	Route            *string
+	Drug             Drug
+	Fills            []PrescriptionFill
+	IssuedAt         time.Time
+	ExpiredAt        time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	DeletedAt        *time.Time
+}
+
+type PrescriptionWarnings struct {
+	Allergies    []AllergyWarning
+	Interactions []DrugInteractionWarning
 }
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type PrescriptionFill struct {
+	ID             uint
+	PrescriptionID uint
+	Prescription   Prescription
+	OrderDetailID  uint
+	OrderID        uint
+	OrderStatus    string
+	Quantity       uint
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
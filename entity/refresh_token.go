@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type RefreshToken struct {
+	ID         uint
+	ActorType  string
+	ActorID    uint
+	ActorEmail string
+	Token      string
+	Family     string
+	ExpiredAt  time.Time
+	UsedAt     *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
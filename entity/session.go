@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type Session struct {
+	ID        string
+	ActorType string
+	ActorID   uint
+	RevokedAt *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -5,10 +5,16 @@ import "time"
 type Telemedicine struct {
 	ID                    uint
 	User                  User
+	DependentID           *uint
+	Dependent             *Dependent
 	Doctor                Doctor
 	EndAt                 *time.Time
-	Diagnose              *string
 	Price                 int
+	PaymentID             *uint
+	StartAt               *time.Time
+	AdmittedAt            *time.Time
+	Status                string
+	SessionStatus         string
 	StartRestAt           *time.Time
 	RestDuration          *int
 	MedicalCertificateURL *string
@@ -17,4 +23,6 @@ type Telemedicine struct {
 	UpdatedAt             time.Time
 	DeletedAt             *time.Time
 	Prescriptions         []Prescription
+	ClinicalNote          *ClinicalNote
+	HealthProfile         *HealthProfile
 }
@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type TelemedicineQueue struct {
+	TelemedicineID uint
+	Position       int
+	EstimatedWait  time.Duration
+	AdmittedAt     *time.Time
+}
@@ -35,12 +35,56 @@ func (h *AdminHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	utils.SendLoginCookie(ctx, token)
+	utils.SendLoginCookie(ctx, *token)
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.LoginPassedMsg,
 	})
 }
 
+func (h *AdminHandler) Refresh(ctx *gin.Context) {
+	refreshToken, err := request.GetRefreshToken(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	token, err := h.adminUsecase.Refresh(ctx, refreshToken)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLoginCookie(ctx, *token)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.RefreshTokenMsg,
+	})
+}
+
+func (h *AdminHandler) Logout(ctx *gin.Context) {
+	refreshToken, _ := request.GetRefreshToken(ctx)
+	if err := h.adminUsecase.Logout(ctx, refreshToken); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLogoutCookie(ctx)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.LogoutMsg,
+	})
+}
+
+func (h *AdminHandler) LogoutAll(ctx *gin.Context) {
+	if err := h.adminUsecase.LogoutAll(ctx); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLogoutCookie(ctx)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.LogoutAllMsg,
+	})
+}
+
 func (h *AdminHandler) GetProfile(ctx *gin.Context) {
 	admin, err := h.adminUsecase.GetProfile(ctx)
 	if err != nil {
@@ -54,6 +98,23 @@ func (h *AdminHandler) GetProfile(ctx *gin.Context) {
 	})
 }
 
+func (h *AdminHandler) UpdatePassword(ctx *gin.Context) {
+	body := new(request.UserPasswordEdit)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if err := h.adminUsecase.UpdatePassword(ctx, body.OldPassword, body.NewPassword); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataEditMsg,
+	})
+}
+
 func (h *AdminHandler) GetAllDoctor(ctx *gin.Context) {
 	collection := request.GetCollectionQuery(ctx)
 	doctors, err := h.adminUsecase.GetAllDoctor(ctx, &collection)
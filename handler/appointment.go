@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/request"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AppointmentHandler struct {
+	appointmentUsecase usecase.AppointmentUsecase
+}
+
+func NewAppointmentHandler(appointmentUsecase usecase.AppointmentUsecase) *AppointmentHandler {
+	return &AppointmentHandler{
+		appointmentUsecase: appointmentUsecase,
+	}
+}
+
+func (h *AppointmentHandler) BookAppointment(ctx *gin.Context) {
+	body := new(request.BookAppointment)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	appointment, err := h.appointmentUsecase.BookAppointment(ctx, body.Appointment())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.Body{
+		Message: constant.DataCreatedMsg,
+		Data:    response.NewAppointmentDTO(*appointment),
+	})
+}
+
+func (h *AppointmentHandler) GetAllDoctorAppointments(ctx *gin.Context) {
+	collection := request.GetCollectionQuery(ctx)
+	appointments, err := h.appointmentUsecase.GetAllDoctorAppointments(ctx, &collection)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message:    constant.DataRetrievedMsg,
+		Data:       response.NewMultipleAppointmentDTO(appointments),
+		Pagination: response.NewPaginationDto(collection),
+	})
+}
+
+func (h *AppointmentHandler) CancelAppointment(ctx *gin.Context) {
+	appointmentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || appointmentID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	if err := h.appointmentUsecase.CancelAppointment(ctx, uint(appointmentID)); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.CancelAppointmentMsg,
+	})
+}
+
+func (h *AppointmentHandler) RescheduleAppointment(ctx *gin.Context) {
+	appointmentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || appointmentID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	body := new(request.RescheduleAppointment)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	appointment, err := h.appointmentUsecase.RescheduleAppointment(ctx, uint(appointmentID), body.ParsedStartAt())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataEditMsg,
+		Data:    response.NewAppointmentDTO(*appointment),
+	})
+}
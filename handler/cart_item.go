@@ -96,3 +96,22 @@ func (h *CartItemHandler) DeleteManyCartItem(ctx *gin.Context) {
 		Message: constant.DataDeletedMsg,
 	})
 }
+
+func (h *CartItemHandler) RedeemPrescription(ctx *gin.Context) {
+	telemedicineID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || telemedicineID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	items, expired, err := h.cartItemUsecase.RedeemPrescription(ctx, uint(telemedicineID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.Body{
+		Message: constant.DataCreatedMsg,
+		Data:    response.NewRedeemPrescriptionDto(items, expired),
+	})
+}
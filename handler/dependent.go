@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/request"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DependentHandler struct {
+	dependentUsecase usecase.DependentUsecase
+}
+
+func NewDependentHandler(dependentUsecase usecase.DependentUsecase) *DependentHandler {
+	return &DependentHandler{
+		dependentUsecase: dependentUsecase,
+	}
+}
+
+func (h *DependentHandler) GetAllDependents(ctx *gin.Context) {
+	dependents, err := h.dependentUsecase.GetAllDependents(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewMultipleDependentDTO(dependents),
+	})
+}
+
+func (h *DependentHandler) GetDependentByID(ctx *gin.Context) {
+	dependentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || dependentID < 1 {
+		ctx.Error(apperror.InvalidParam)
+		return
+	}
+
+	dependent, err := h.dependentUsecase.GetDependentByID(ctx, uint(dependentID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewDependentDTO(*dependent),
+	})
+}
+
+func (h *DependentHandler) AddDependent(ctx *gin.Context) {
+	body := new(request.Dependent)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	dependent, err := h.dependentUsecase.AddDependent(ctx, body.Dependent())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.Body{
+		Message: constant.DataCreatedMsg,
+		Data:    response.NewDependentDTO(*dependent),
+	})
+}
+
+func (h *DependentHandler) UpdateDependent(ctx *gin.Context) {
+	dependentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || dependentID < 1 {
+		ctx.Error(apperror.InvalidParam)
+		return
+	}
+
+	body := new(request.Dependent)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	dependent := body.Dependent()
+	dependent.ID = uint(dependentID)
+	updated, err := h.dependentUsecase.UpdateDependent(ctx, dependent)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataEditMsg,
+		Data:    response.NewDependentDTO(*updated),
+	})
+}
+
+func (h *DependentHandler) DeleteDependent(ctx *gin.Context) {
+	dependentID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || dependentID < 1 {
+		ctx.Error(apperror.InvalidParam)
+		return
+	}
+
+	err = h.dependentUsecase.DeleteDependent(ctx, uint(dependentID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataDeletedMsg,
+	})
+}
@@ -37,7 +37,7 @@ func (h *DoctorHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	utils.SendLoginCookie(ctx, token)
+	utils.SendLoginCookie(ctx, *token)
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.LoginPassedMsg,
 	})
@@ -56,12 +56,56 @@ func (h *DoctorHandler) LoginOAuth(ctx *gin.Context) {
 		return
 	}
 
-	utils.SendLoginCookie(ctx, token)
+	utils.SendLoginCookie(ctx, *token)
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.LoginPassedMsg,
 	})
 }
 
+func (h *DoctorHandler) Refresh(ctx *gin.Context) {
+	refreshToken, err := request.GetRefreshToken(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	token, err := h.doctorUsecase.Refresh(ctx, refreshToken)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLoginCookie(ctx, *token)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.RefreshTokenMsg,
+	})
+}
+
+func (h *DoctorHandler) Logout(ctx *gin.Context) {
+	refreshToken, _ := request.GetRefreshToken(ctx)
+	if err := h.doctorUsecase.Logout(ctx, refreshToken); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLogoutCookie(ctx)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.LogoutMsg,
+	})
+}
+
+func (h *DoctorHandler) LogoutAll(ctx *gin.Context) {
+	if err := h.doctorUsecase.LogoutAll(ctx); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLogoutCookie(ctx)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.LogoutAllMsg,
+	})
+}
+
 func (h *DoctorHandler) Register(ctx *gin.Context) {
 	body := new(request.DoctorRegister)
 	if err := ctx.ShouldBindJSON(body); err != nil {
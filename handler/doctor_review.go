@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/request"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DoctorReviewHandler struct {
+	doctorReviewUsecase usecase.DoctorReviewUsecase
+}
+
+func NewDoctorReviewHandler(doctorReviewUsecase usecase.DoctorReviewUsecase) *DoctorReviewHandler {
+	return &DoctorReviewHandler{
+		doctorReviewUsecase: doctorReviewUsecase,
+	}
+}
+
+func (h *DoctorReviewHandler) AddReview(ctx *gin.Context) {
+	telemedicineID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || telemedicineID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	body := new(request.DoctorReview)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	review, err := h.doctorReviewUsecase.AddReview(ctx, body.DoctorReview(uint(telemedicineID)))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.Body{
+		Message: constant.DataCreatedMsg,
+		Data:    response.NewDoctorReviewDTO(*review),
+	})
+}
+
+func (h *DoctorReviewHandler) GetAllDoctorReviews(ctx *gin.Context) {
+	doctorID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || doctorID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	collection := request.GetCollectionQuery(ctx)
+	reviews, err := h.doctorReviewUsecase.GetAllDoctorReviews(ctx, uint(doctorID), &collection)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message:    constant.DataRetrievedMsg,
+		Data:       response.NewMultipleDoctorReviewDTO(reviews),
+		Pagination: response.NewPaginationDto(collection),
+	})
+}
+
+func (h *DoctorReviewHandler) GetAllReviews(ctx *gin.Context) {
+	collection := request.GetCollectionQuery(ctx)
+	reviews, err := h.doctorReviewUsecase.GetAllReviews(ctx, &collection)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message:    constant.DataRetrievedMsg,
+		Data:       response.NewMultipleDoctorReviewDTO(reviews),
+		Pagination: response.NewPaginationDto(collection),
+	})
+}
+
+func (h *DoctorReviewHandler) UpdateVisibility(ctx *gin.Context) {
+	reviewID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || reviewID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	body := new(request.DoctorReviewVisibility)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	review, err := h.doctorReviewUsecase.UpdateHidden(ctx, uint(reviewID), *body.IsHidden)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataEditMsg,
+		Data:    response.NewDoctorReviewDTO(*review),
+	})
+}
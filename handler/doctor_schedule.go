@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/request"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DoctorScheduleHandler struct {
+	doctorScheduleUsecase usecase.DoctorScheduleUsecase
+}
+
+func NewDoctorScheduleHandler(doctorScheduleUsecase usecase.DoctorScheduleUsecase) *DoctorScheduleHandler {
+	return &DoctorScheduleHandler{
+		doctorScheduleUsecase: doctorScheduleUsecase,
+	}
+}
+
+func (h *DoctorScheduleHandler) GetAllSchedules(ctx *gin.Context) {
+	schedules, err := h.doctorScheduleUsecase.GetAllSchedules(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewMultipleDoctorScheduleDTO(schedules),
+	})
+}
+
+func (h *DoctorScheduleHandler) AddSchedule(ctx *gin.Context) {
+	body := new(request.DoctorSchedule)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	schedule, err := h.doctorScheduleUsecase.AddSchedule(ctx, body.DoctorSchedule())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.Body{
+		Message: constant.DataCreatedMsg,
+		Data:    response.NewDoctorScheduleDTO(*schedule),
+	})
+}
+
+func (h *DoctorScheduleHandler) DeleteSchedule(ctx *gin.Context) {
+	scheduleID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || scheduleID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	if err := h.doctorScheduleUsecase.DeleteSchedule(ctx, uint(scheduleID)); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataDeletedMsg,
+	})
+}
+
+func (h *DoctorScheduleHandler) GetAllScheduleExceptions(ctx *gin.Context) {
+	exceptions, err := h.doctorScheduleUsecase.GetAllScheduleExceptions(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewMultipleDoctorScheduleExceptionDTO(exceptions),
+	})
+}
+
+func (h *DoctorScheduleHandler) AddScheduleException(ctx *gin.Context) {
+	body := new(request.DoctorScheduleException)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	exception, err := h.doctorScheduleUsecase.AddScheduleException(ctx, body.DoctorScheduleException())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.Body{
+		Message: constant.DataCreatedMsg,
+		Data:    response.NewDoctorScheduleExceptionDTO(*exception),
+	})
+}
+
+func (h *DoctorScheduleHandler) DeleteScheduleException(ctx *gin.Context) {
+	exceptionID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || exceptionID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	if err := h.doctorScheduleUsecase.DeleteScheduleException(ctx, uint(exceptionID)); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataDeletedMsg,
+	})
+}
+
+func (h *DoctorScheduleHandler) GetAvailableSlots(ctx *gin.Context) {
+	doctorID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || doctorID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	query := new(request.SlotQuery)
+	if err := ctx.ShouldBindQuery(query); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	slots, err := h.doctorScheduleUsecase.GetAvailableSlots(ctx, uint(doctorID), query.ParsedDate())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewMultipleSlotDTO(slots),
+	})
+}
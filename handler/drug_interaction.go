@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"net/http"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/request"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DrugInteractionHandler struct {
+	drugInteractionUsecase usecase.DrugInteractionUsecase
+}
+
+func NewDrugInteractionHandler(drugInteractionUsecase usecase.DrugInteractionUsecase) *DrugInteractionHandler {
+	return &DrugInteractionHandler{
+		drugInteractionUsecase: drugInteractionUsecase,
+	}
+}
+
+func (h *DrugInteractionHandler) ImportInteractions(ctx *gin.Context) {
+	body := new(request.ImportDrugInteraction)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	imported, err := h.drugInteractionUsecase.ImportDrugInteractions(ctx, body.DrugInteractions())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataCreatedUpdatedMsg,
+		Data:    response.DrugInteractionImportDTO{Imported: imported},
+	})
+}
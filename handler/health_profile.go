@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"net/http"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/request"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type HealthProfileHandler struct {
+	healthProfileUsecase usecase.HealthProfileUsecase
+}
+
+func NewHealthProfileHandler(healthProfileUsecase usecase.HealthProfileUsecase) *HealthProfileHandler {
+	return &HealthProfileHandler{
+		healthProfileUsecase: healthProfileUsecase,
+	}
+}
+
+func (h *HealthProfileHandler) GetHealthProfile(ctx *gin.Context) {
+	profile, err := h.healthProfileUsecase.GetHealthProfile(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewHealthProfileDTO(*profile),
+	})
+}
+
+func (h *HealthProfileHandler) UpdateHealthProfile(ctx *gin.Context) {
+	body := new(request.HealthProfile)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	profile, err := h.healthProfileUsecase.UpdateHealthProfile(ctx, body.HealthProfile())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataEditMsg,
+		Data:    response.NewHealthProfileDTO(*profile),
+	})
+}
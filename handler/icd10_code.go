@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/request"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ICD10CodeHandler struct {
+	icd10CodeUsecase usecase.ICD10CodeUsecase
+}
+
+func NewICD10CodeHandler(icd10CodeUsecase usecase.ICD10CodeUsecase) *ICD10CodeHandler {
+	return &ICD10CodeHandler{
+		icd10CodeUsecase: icd10CodeUsecase,
+	}
+}
+
+func (h *ICD10CodeHandler) GetAllCodes(ctx *gin.Context) {
+	collection := request.GetCollectionQuery(ctx)
+	codes, err := h.icd10CodeUsecase.GetAllCodes(ctx, &collection)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message:    constant.DataRetrievedMsg,
+		Data:       response.NewMultipleICD10CodeDTO(codes),
+		Pagination: response.NewPaginationDto(collection),
+	})
+}
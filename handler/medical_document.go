@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MedicalDocumentHandler struct {
+	medicalDocumentUsecase usecase.MedicalDocumentUsecase
+}
+
+func NewMedicalDocumentHandler(medicalDocumentUsecase usecase.MedicalDocumentUsecase) *MedicalDocumentHandler {
+	return &MedicalDocumentHandler{
+		medicalDocumentUsecase: medicalDocumentUsecase,
+	}
+}
+
+func (h *MedicalDocumentHandler) VerifyDocument(ctx *gin.Context) {
+	code := strings.ToUpper(strings.TrimSpace(ctx.Param("code")))
+
+	document, err := h.medicalDocumentUsecase.VerifyDocument(ctx, code)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewMedicalDocumentVerificationDTO(*document),
+	})
+}
@@ -2,11 +2,14 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
 	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/dto/request"
 	"Alice-Seahat-Healthcare/seahat-be/dto/response"
 	"Alice-Seahat-Healthcare/seahat-be/usecase"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -39,3 +42,77 @@ func (h *MessageBubbleHandler) AddChat(ctx *gin.Context) {
 		Data:    response.NewMessageBubbleDTO(*data),
 	})
 }
+
+func (h *MessageBubbleHandler) GetAllChat(ctx *gin.Context) {
+	telemedicineID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || telemedicineID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	query := new(request.MessageBubbleQuery)
+	if err := ctx.ShouldBindQuery(query); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	messageBubbles, err := h.messageBubbleUseCase.GetAllMessageBubbles(ctx, uint(telemedicineID), query.Cursor())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewMultipleMessageBubbleDTO(messageBubbles),
+	})
+}
+
+func (h *MessageBubbleHandler) AddAttachment(ctx *gin.Context) {
+	telemedicineID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || telemedicineID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	body := new(request.MessageAttachment)
+	if err := ctx.ShouldBind(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if int(body.File.Size) > constant.MaxFileSize[body.Type] {
+		ctx.Error(apperror.FileTooLarge)
+		return
+	}
+
+	fileType, err := utils.SniffingFile(body.File)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if fileType != constant.FileType[body.Type] {
+		ctx.Error(apperror.FileInvalidType)
+		return
+	}
+
+	file, err := body.File.Open()
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	defer file.Close()
+
+	data, err := h.messageBubbleUseCase.AddAttachment(ctx, body.MessageBubble(uint(telemedicineID), fileType), file)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, response.Body{
+		Message: constant.DataCreatedMsg,
+		Data:    response.NewMessageBubbleDTO(*data),
+	})
+}
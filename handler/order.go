@@ -32,7 +32,7 @@ func (h *OrderHandler) CreateOrder(ctx *gin.Context) {
 	}
 
 	ordersReq := req.OrderDTO()
-	orders, err := h.orderUsecase.CreateOrder(ctx, ordersReq)
+	orders, interactions, err := h.orderUsecase.CreateOrder(ctx, ordersReq)
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -48,6 +48,7 @@ func (h *OrderHandler) CreateOrder(ctx *gin.Context) {
 	}
 	payment.Orders = orderPayment
 	res := response.NewPaymentDto(payment)
+	res.InteractionWarnings = response.NewMultipleDrugInteractionWarningDTO(interactions)
 
 	ctx.JSON(http.StatusCreated, response.Body{
 		Message: constant.OrderCreatedSuccessfully,
@@ -78,6 +79,56 @@ func (h *OrderHandler) UpdateConfirmOrder(ctx *gin.Context) {
 
 }
 
+func (h *OrderHandler) DisputeOrder(ctx *gin.Context) {
+	orderId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || orderId < 1 {
+		ctx.Error(apperror.InvalidParam)
+		return
+	}
+
+	body := new(request.OrderDispute)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	order, err := h.orderUsecase.DisputeOrder(ctx, entity.Order{Id: uint(orderId), DisputeReason: &body.Reason})
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DisputeOrderMsg,
+		Data:    response.NewOrderDto(*order),
+	})
+}
+
+func (h *OrderHandler) ResolveDispute(ctx *gin.Context) {
+	orderId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || orderId < 1 {
+		ctx.Error(apperror.InvalidParam)
+		return
+	}
+
+	body := new(request.OrderResolveDispute)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	order, err := h.orderUsecase.ResolveDispute(ctx, entity.Order{Id: uint(orderId)}, body.Status(), body.Reason)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.ResolveDisputeMsg,
+		Data:    response.NewOrderDto(*order),
+	})
+}
+
 func (h *OrderHandler) OrderProceed(ctx *gin.Context) {
 	orderReq := entity.Order{}
 	id := ctx.Param("id")
@@ -89,7 +140,7 @@ func (h *OrderHandler) OrderProceed(ctx *gin.Context) {
 
 	orderReq.Id = uint(orderId)
 
-	_, err = h.orderUsecase.OrderProceed(ctx, orderReq)
+	order, err := h.orderUsecase.OrderProceed(ctx, orderReq)
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -97,6 +148,7 @@ func (h *OrderHandler) OrderProceed(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.OrderConfirmed,
+		Data:    response.NewMultiplePrescriptionFillDto(order.PrescriptionFills),
 	})
 
 }
@@ -157,3 +209,56 @@ func (h *OrderHandler) OrderCancelByPM(ctx *gin.Context) {
 		Message: constant.CancelOrderMsg,
 	})
 }
+
+func (h *OrderHandler) GetOrderTimeline(ctx *gin.Context) {
+	orderId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || orderId < 1 {
+		ctx.Error(apperror.InvalidParam)
+		return
+	}
+
+	histories, err := h.orderUsecase.GetOrderTimeline(ctx, uint(orderId))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewMultipleOrderStatusHistoryDTO(histories),
+	})
+}
+
+func (h *OrderHandler) GetAllOrderByUser(ctx *gin.Context) {
+	collection := request.GetCollectionQuery(ctx)
+	orders, err := h.orderUsecase.GetAllOrderByUserId(ctx, &collection)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message:    constant.DataRetrievedMsg,
+		Data:       response.NewMultipleUserOrderDto(orders),
+		Pagination: response.NewPaginationDto(collection),
+	})
+}
+
+func (h *OrderHandler) GetOrderByUser(ctx *gin.Context) {
+	orderId, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || orderId < 1 {
+		ctx.Error(apperror.InvalidParam)
+		return
+	}
+
+	order, err := h.orderUsecase.GetOrderByUserId(ctx, uint(orderId))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewUserOrderDetailDto(*order),
+	})
+}
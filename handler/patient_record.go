@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/dto/request"
+	"Alice-Seahat-Healthcare/seahat-be/dto/response"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PatientRecordHandler struct {
+	patientRecordUsecase usecase.PatientRecordUsecase
+}
+
+func NewPatientRecordHandler(patientRecordUsecase usecase.PatientRecordUsecase) *PatientRecordHandler {
+	return &PatientRecordHandler{
+		patientRecordUsecase: patientRecordUsecase,
+	}
+}
+
+func (h *PatientRecordHandler) GetPatientRecord(ctx *gin.Context) {
+	telemedicineID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || telemedicineID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	access := entity.PatientRecordAccessLog{
+		TelemedicineID: uint(telemedicineID),
+		IPAddress:      ctx.ClientIP(),
+		UserAgent:      ctx.Request.UserAgent(),
+	}
+
+	collection := request.GetCollectionQuery(ctx)
+	record, err := h.patientRecordUsecase.GetPatientRecord(ctx, access, &collection)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message:    constant.DataRetrievedMsg,
+		Data:       response.NewPatientRecordDTO(*record),
+		Pagination: response.NewPaginationDto(collection),
+	})
+}
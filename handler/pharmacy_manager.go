@@ -35,12 +35,56 @@ func (h *PharmacyManagerHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	utils.SendLoginCookie(ctx, token)
+	utils.SendLoginCookie(ctx, *token)
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.LoginPassedMsg,
 	})
 }
 
+func (h *PharmacyManagerHandler) Refresh(ctx *gin.Context) {
+	refreshToken, err := request.GetRefreshToken(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	token, err := h.pharmacyManager.Refresh(ctx, refreshToken)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLoginCookie(ctx, *token)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.RefreshTokenMsg,
+	})
+}
+
+func (h *PharmacyManagerHandler) Logout(ctx *gin.Context) {
+	refreshToken, _ := request.GetRefreshToken(ctx)
+	if err := h.pharmacyManager.Logout(ctx, refreshToken); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLogoutCookie(ctx)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.LogoutMsg,
+	})
+}
+
+func (h *PharmacyManagerHandler) LogoutAll(ctx *gin.Context) {
+	if err := h.pharmacyManager.LogoutAll(ctx); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLogoutCookie(ctx)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.LogoutAllMsg,
+	})
+}
+
 func (h *PharmacyManagerHandler) GetProfile(ctx *gin.Context) {
 	manager, err := h.pharmacyManager.GetProfile(ctx)
 	if err != nil {
@@ -53,3 +97,20 @@ func (h *PharmacyManagerHandler) GetProfile(ctx *gin.Context) {
 		Data:    response.NewPharmacyManagerDto(*manager),
 	})
 }
+
+func (h *PharmacyManagerHandler) UpdatePassword(ctx *gin.Context) {
+	body := new(request.UserPasswordEdit)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	if err := h.pharmacyManager.UpdatePassword(ctx, body.OldPassword, body.NewPassword); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataEditMsg,
+	})
+}
@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
 	"Alice-Seahat-Healthcare/seahat-be/constant"
@@ -120,7 +122,7 @@ func (h *TelemedicineHandler) AddPrescriptions(ctx *gin.Context) {
 		return
 	}
 
-	url, err := h.telemedicineUsecase.AddManyPrescriptedDrugs(ctx, body.Prescription(uint(telemedicineID)))
+	url, warnings, err := h.telemedicineUsecase.AddManyPrescriptedDrugs(ctx, body.Prescription(uint(telemedicineID)))
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -128,9 +130,34 @@ func (h *TelemedicineHandler) AddPrescriptions(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.DataEditMsg,
-		Data:    url,
+		Data:    response.NewPrescriptionResultDTO(*url, *warnings),
+	})
+}
+func (h *TelemedicineHandler) SaveClinicalNote(ctx *gin.Context) {
+	telemedicineID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || telemedicineID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	body := new(request.ClinicalNote)
+	if err := ctx.ShouldBindJSON(body); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	note, err := h.telemedicineUsecase.SaveClinicalNote(ctx, body.ClinicalNote(uint(telemedicineID)))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataCreatedUpdatedMsg,
+		Data:    response.NewClinicalNoteDTO(*note),
 	})
 }
+
 func (h *TelemedicineHandler) GenerateMedicalCertificate(ctx *gin.Context) {
 	id := ctx.Param("id")
 	telemedicineId, err := strconv.Atoi(id)
@@ -159,3 +186,60 @@ func (h *TelemedicineHandler) GenerateMedicalCertificate(ctx *gin.Context) {
 	})
 
 }
+
+func (h *TelemedicineHandler) StreamTelemedicine(ctx *gin.Context) {
+	telemedicineID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || telemedicineID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	events, unsubscribe, err := h.telemedicineUsecase.Subscribe(ctx, uint(telemedicineID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	defer unsubscribe()
+
+	keepAlive := time.NewTicker(constant.StreamKeepAlive)
+	defer keepAlive.Stop()
+
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return false
+			}
+
+			ctx.SSEvent(event.Name, response.NewEventDTO(event))
+			return true
+		case <-keepAlive.C:
+			ctx.SSEvent(constant.EventPing, time.Now())
+			return true
+		case <-ctx.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+func (h *TelemedicineHandler) GetQueue(ctx *gin.Context) {
+	telemedicineID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil || telemedicineID < 1 {
+		ctx.Error(apperror.InvalidIdParams)
+		return
+	}
+
+	queue, err := h.telemedicineUsecase.GetQueue(ctx, uint(telemedicineID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.DataRetrievedMsg,
+		Data:    response.NewTelemedicineQueueDTO(*queue),
+	})
+}
@@ -35,7 +35,7 @@ func (h *UserHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	utils.SendLoginCookie(ctx, token)
+	utils.SendLoginCookie(ctx, *token)
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.LoginPassedMsg,
 	})
@@ -54,12 +54,31 @@ func (h *UserHandler) LoginOAuth(ctx *gin.Context) {
 		return
 	}
 
-	utils.SendLoginCookie(ctx, token)
+	utils.SendLoginCookie(ctx, *token)
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.LoginPassedMsg,
 	})
 }
 
+func (h *UserHandler) Refresh(ctx *gin.Context) {
+	refreshToken, err := request.GetRefreshToken(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	token, err := h.userUsecase.Refresh(ctx, refreshToken)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLoginCookie(ctx, *token)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.RefreshTokenMsg,
+	})
+}
+
 func (h *UserHandler) Register(ctx *gin.Context) {
 	body := new(request.UserRegister)
 	if err := ctx.ShouldBindJSON(body); err != nil {
@@ -152,12 +171,30 @@ func (h *UserHandler) ResetPassword(ctx *gin.Context) {
 }
 
 func (h *UserHandler) Logout(ctx *gin.Context) {
+	refreshToken, _ := request.GetRefreshToken(ctx)
+	if err := h.userUsecase.Logout(ctx, refreshToken); err != nil {
+		ctx.Error(err)
+		return
+	}
+
 	utils.SendLogoutCookie(ctx)
 	ctx.JSON(http.StatusOK, response.Body{
 		Message: constant.LogoutMsg,
 	})
 }
 
+func (h *UserHandler) LogoutAll(ctx *gin.Context) {
+	if err := h.userUsecase.LogoutAll(ctx); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	utils.SendLogoutCookie(ctx)
+	ctx.JSON(http.StatusOK, response.Body{
+		Message: constant.LogoutAllMsg,
+	})
+}
+
 func (h *UserHandler) UpdatePersonal(ctx *gin.Context) {
 	body := new(request.UserPersonalEdit)
 	if err := ctx.ShouldBindJSON(body); err != nil {
@@ -0,0 +1,77 @@
+package hub
+
+import (
+	"fmt"
+	"sync"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Event struct {
+	Name string
+	Data any
+}
+
+type Hub interface {
+	Publish(topic string, event Event)
+	Subscribe(topic string) (<-chan Event, func())
+}
+
+type hubImpl struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[chan Event]struct{}
+}
+
+func New() *hubImpl {
+	return &hubImpl{
+		subscribers: make(map[string]map[chan Event]struct{}),
+	}
+}
+
+func TelemedicineTopic(id uint) string {
+	return fmt.Sprintf("telemedicine:%d", id)
+}
+
+func (h *hubImpl) Publish(topic string, event Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.subscribers[topic] {
+		select {
+		case sub <- event:
+		default:
+			logrus.Warnf("dropping %s event on %s for slow subscriber", event.Name, topic)
+		}
+	}
+}
+
+func (h *hubImpl) Subscribe(topic string) (<-chan Event, func()) {
+	sub := make(chan Event, constant.HubBufferSize)
+
+	h.mu.Lock()
+	if _, ok := h.subscribers[topic]; !ok {
+		h.subscribers[topic] = make(map[chan Event]struct{})
+	}
+
+	h.subscribers[topic][sub] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+
+			delete(h.subscribers[topic], sub)
+			if len(h.subscribers[topic]) == 0 {
+				delete(h.subscribers, topic)
+			}
+
+			close(sub)
+		})
+	}
+
+	return sub, unsubscribe
+}
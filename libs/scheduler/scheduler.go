@@ -0,0 +1,80 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+type Scheduler interface {
+	Register(job Job)
+	Start(ctx context.Context)
+	Stop()
+}
+
+type schedulerImpl struct {
+	mu     sync.Mutex
+	wg     sync.WaitGroup
+	jobs   []Job
+	cancel context.CancelFunc
+}
+
+func New() *schedulerImpl {
+	return &schedulerImpl{}
+}
+
+func (s *schedulerImpl) Register(job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobs = append(s.jobs, job)
+}
+
+func (s *schedulerImpl) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx, s.cancel = context.WithCancel(ctx)
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.run(ctx, job)
+	}
+}
+
+func (s *schedulerImpl) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	s.wg.Wait()
+}
+
+func (s *schedulerImpl) run(ctx context.Context, job Job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job.Run(ctx); err != nil {
+				logrus.WithField("job", job.Name).Error(err)
+			}
+		}
+	}
+}
@@ -15,6 +15,7 @@ func SetCustom(validate any) {
 		v.RegisterTagNameFunc(fieldTagNew)
 		v.RegisterValidation("date", isDateTimeFormat(constant.DateFormat))
 		v.RegisterValidation("datetime", isDateTimeFormat(constant.FullTimeFormat))
+		v.RegisterValidation("time", isDateTimeFormat(constant.TimeFormat))
 	}
 }
 
@@ -24,6 +24,8 @@ func GetValidationErrorMsg(fe validator.FieldError) string {
 		return "should be date (yyyy-mm-dd) format"
 	case "datetime":
 		return "should be date (yyyy-mm-dd hh:mm:ss) format"
+	case "time":
+		return "should be time (hh:mm:ss) format"
 	case "unique":
 		return "should be unique"
 	case "min":
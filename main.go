@@ -12,8 +12,10 @@ import (
 	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/database"
 	"Alice-Seahat-Healthcare/seahat-be/libs/firebase"
+	"Alice-Seahat-Healthcare/seahat-be/libs/hub"
 	"Alice-Seahat-Healthcare/seahat-be/libs/mail"
 	"Alice-Seahat-Healthcare/seahat-be/libs/rajaongkir"
+	"Alice-Seahat-Healthcare/seahat-be/libs/scheduler"
 	"Alice-Seahat-Healthcare/seahat-be/server"
 
 	"github.com/sirupsen/logrus"
@@ -50,7 +52,8 @@ func main() {
 
 	defer appLog.Close()
 
-	handler := server.NewServer(db, dialer, appLog, ro, firebase).SetupServer()
+	worker := scheduler.New()
+	handler := server.NewServer(db, dialer, appLog, ro, firebase, hub.New(), worker).SetupServer()
 	srv := http.Server{
 		Addr:    fmt.Sprintf(":%d", config.App.Port),
 		Handler: handler,
@@ -62,12 +65,16 @@ func main() {
 		}
 	}()
 
+	worker.Start(context.Background())
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	<-quit
 
 	logrus.Info("Shutdown server...")
+	worker.Stop()
+
 	ctx, cancel := context.WithTimeout(context.Background(), constant.TimeoutShutdown)
 	defer cancel()
 
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"errors"
 	"strings"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
@@ -36,10 +37,24 @@ func (m *Middleware) Authentication(jwtFunc func(signed string) (jwt.MapClaims,
 			return
 		}
 
-		if role, ok := user["role"]; ok {
-			userDataMap["role"] = role
+		role, _ := user["role"].(string)
+		sessionID, _ := user["jti"].(string)
+		actorID, _ := userDataMap["ID"].(float64)
+
+		_, err := m.sessionRepository.SelectOneActiveByID(ctx, sessionID, role, uint(actorID))
+		if err != nil {
+			if errors.Is(err, apperror.ErrResourceNotFound) {
+				err = apperror.Unauthorized
+			}
+
+			ctx.Error(err)
+			ctx.Abort()
+			return
 		}
 
+		userDataMap["role"] = role
+		userDataMap["SessionID"] = sessionID
+
 		ctx.Set(constant.UserContext, userDataMap)
 	}
 }
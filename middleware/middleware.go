@@ -1,7 +1,13 @@
 package middleware
 
-type Middleware struct{}
+import "Alice-Seahat-Healthcare/seahat-be/repository"
 
-func NewMiddleware() *Middleware {
-	return &Middleware{}
+type Middleware struct {
+	sessionRepository repository.SessionRepository
+}
+
+func NewMiddleware(sessionRepository repository.SessionRepository) *Middleware {
+	return &Middleware{
+		sessionRepository: sessionRepository,
+	}
 }
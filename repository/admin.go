@@ -14,6 +14,7 @@ import (
 
 type AdminRepository interface {
 	SelectOneByEmail(ctx context.Context, email string) (*entity.Admin, error)
+	UpdatePassword(ctx context.Context, id uint, password string) error
 }
 
 type adminRepositoryImpl struct {
@@ -60,3 +61,26 @@ func (r *adminRepositoryImpl) SelectOneByEmail(ctx context.Context, email string
 
 	return &scan, nil
 }
+
+func (r *adminRepositoryImpl) UpdatePassword(ctx context.Context, id uint, password string) error {
+	q := `
+		UPDATE admins
+		SET
+			admin_password = $1,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			admin_id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, q, password, id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
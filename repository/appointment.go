@@ -0,0 +1,263 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	appointmentColumnAlias = map[string]string{
+		"status":   "status",
+		"start_at": "start_at",
+	}
+)
+
+type AppointmentRepository interface {
+	SelectAllByDoctorID(ctx context.Context, doctorID uint, clc *entity.Collection) ([]entity.Appointment, error)
+	SelectAllBookedByDoctorIDBetween(ctx context.Context, doctorID uint, from time.Time, to time.Time) ([]entity.Appointment, error)
+	SelectOneByID(ctx context.Context, id uint, doctorID uint) (*entity.Appointment, error)
+	InsertOne(ctx context.Context, appointment entity.Appointment) (*entity.Appointment, error)
+	UpdateStatus(ctx context.Context, id uint, status string) error
+	UpdateSchedule(ctx context.Context, id uint, startAt time.Time, endAt time.Time) error
+}
+
+type appointmentRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewAppointmentRepository(db transaction.DBTransaction) *appointmentRepositoryImpl {
+	return &appointmentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *appointmentRepositoryImpl) SelectAllByDoctorID(ctx context.Context, doctorID uint, clc *entity.Collection) ([]entity.Appointment, error) {
+	selectColumns := `
+		appointment_id, doctor_id, user_id, telemedicine_id, start_at, end_at, status, created_at
+	`
+	advanceQuery := `
+			appointments
+		WHERE
+			doctor_id = $1 AND
+		%s
+	`
+
+	clc.Args = append(clc.Args, doctorID)
+
+	orderBy := utils.BuildSortQuery(appointmentColumnAlias, clc.Sort, "start_at desc")
+	filter := utils.BuildFilterQuery(appointmentColumnAlias, clc, "1 = 1")
+
+	query := utils.BuildQuery(r.db, utils.PaginateQuery{
+		SelectColumns: selectColumns,
+		AdvanceQuery:  fmt.Sprintf(advanceQuery, filter),
+		OrderQuery:    orderBy,
+	}, clc)
+
+	return r.selectAll(ctx, query, clc.Args...)
+}
+
+func (r *appointmentRepositoryImpl) SelectAllBookedByDoctorIDBetween(ctx context.Context, doctorID uint, from time.Time, to time.Time) ([]entity.Appointment, error) {
+	q := `
+		SELECT
+			appointment_id, doctor_id, user_id, telemedicine_id, start_at, end_at, status, created_at
+		FROM
+			appointments
+		WHERE
+			doctor_id = $1
+		AND
+			status = $2
+		AND
+			start_at < $4
+		AND
+			end_at > $3
+		ORDER BY
+			start_at
+	`
+
+	return r.selectAll(ctx, q, doctorID, constant.AppointmentBooked, from, to)
+}
+
+func (r *appointmentRepositoryImpl) SelectOneByID(ctx context.Context, id uint, doctorID uint) (*entity.Appointment, error) {
+	q := `
+		SELECT
+			appointment_id, doctor_id, user_id, telemedicine_id, start_at, end_at, status, created_at
+		FROM
+			appointments
+		WHERE
+			appointment_id = $1
+		AND
+			doctor_id = $2
+	`
+
+	var scan entity.Appointment
+	err := r.db.QueryRowContext(ctx, q, id, doctorID).Scan(
+		&scan.ID,
+		&scan.DoctorID,
+		&scan.UserID,
+		&scan.TelemedicineID,
+		&scan.StartAt,
+		&scan.EndAt,
+		&scan.Status,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *appointmentRepositoryImpl) InsertOne(ctx context.Context, appointment entity.Appointment) (*entity.Appointment, error) {
+	q := `
+		INSERT INTO appointments
+			(doctor_id, user_id, telemedicine_id, start_at, end_at, status)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			appointment_id, doctor_id, user_id, telemedicine_id, start_at, end_at, status, created_at
+	`
+
+	var scan entity.Appointment
+	err := r.db.QueryRowContext(ctx, q,
+		appointment.DoctorID,
+		appointment.UserID,
+		appointment.TelemedicineID,
+		appointment.StartAt,
+		appointment.EndAt,
+		appointment.Status,
+	).Scan(
+		&scan.ID,
+		&scan.DoctorID,
+		&scan.UserID,
+		&scan.TelemedicineID,
+		&scan.StartAt,
+		&scan.EndAt,
+		&scan.Status,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constant.ExclusionViolationCode {
+			return nil, apperror.ErrSlotUnavailable
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *appointmentRepositoryImpl) UpdateStatus(ctx context.Context, id uint, status string) error {
+	q := `
+		UPDATE
+			appointments
+		SET
+			status = $1,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			appointment_id = $2
+		AND
+			status = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, q, status, id, constant.AppointmentBooked)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (r *appointmentRepositoryImpl) UpdateSchedule(ctx context.Context, id uint, startAt time.Time, endAt time.Time) error {
+	q := `
+		UPDATE
+			appointments
+		SET
+			start_at = $1,
+			end_at = $2,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			appointment_id = $3
+		AND
+			status = $4
+	`
+
+	result, err := r.db.ExecContext(ctx, q, startAt, endAt, id, constant.AppointmentBooked)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constant.ExclusionViolationCode {
+			return apperror.ErrSlotUnavailable
+		}
+
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (r *appointmentRepositoryImpl) selectAll(ctx context.Context, q string, args ...any) ([]entity.Appointment, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	appointments := make([]entity.Appointment, 0)
+	for rows.Next() {
+		var scan entity.Appointment
+		if err := rows.Scan(
+			&scan.ID,
+			&scan.DoctorID,
+			&scan.UserID,
+			&scan.TelemedicineID,
+			&scan.StartAt,
+			&scan.EndAt,
+			&scan.Status,
+			&scan.CreatedAt,
+		); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		appointments = append(appointments, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return appointments, nil
+}
@@ -9,9 +9,11 @@ import (
 	"strings"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/sirupsen/logrus"
 )
 
@@ -43,9 +45,10 @@ func (r *cartItemRepositoryImpl) GetAllWithOtherDetail(ctx context.Context, user
 			c.pharmacy_drug_id, 
 			c.quantity, 
 			c.is_prescripted,
+			c.prescription_id,
 			c.created_at,
 			pd.pharmacy_drug_id,
-			pd.stock,
+			pd.stock - pd.reserved_stock,
 			pd.price,
 			p.pharmacy_id,
 			p.pharmacy_name,
@@ -96,6 +99,7 @@ func (r *cartItemRepositoryImpl) GetAllWithOtherDetail(ctx context.Context, user
 			&scan.PharmacyDrugID,
 			&scan.Quantity,
 			&scan.IsPrescripted,
+			&scan.PrescriptionID,
 			&scan.CreatedAt,
 			&scan.PharmacyDrug.ID,
 			&scan.PharmacyDrug.Stock,
@@ -178,15 +182,16 @@ func (r *cartItemRepositoryImpl) SelectOneByID(ctx context.Context, item entity.
 func (r *cartItemRepositoryImpl) InsertOne(ctx context.Context, item entity.CartItem) (*entity.CartItem, error) {
 	q := `
 		INSERT INTO
-			cart_items (user_id, pharmacy_drug_id, quantity, is_prescripted)
+			cart_items (user_id, pharmacy_drug_id, quantity, is_prescripted, prescription_id)
 		VALUES
-			($1, $2, $3, $4)
+			($1, $2, $3, $4, $5)
 		RETURNING
 			cart_item_id,
 			user_id, 
 			pharmacy_drug_id, 
 			quantity, 
 			is_prescripted,
+			prescription_id,
 			created_at
 	`
 
@@ -196,14 +201,21 @@ func (r *cartItemRepositoryImpl) InsertOne(ctx context.Context, item entity.Cart
 		item.PharmacyDrugID,
 		item.Quantity,
 		item.IsPrescripted,
+		item.PrescriptionID,
 	).Scan(
 		&scan.ID,
 		&scan.UserID,
 		&scan.PharmacyDrugID,
 		&scan.Quantity,
 		&scan.IsPrescripted,
+		&scan.PrescriptionID,
 		&scan.CreatedAt,
 	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constant.UniqueViolationCode {
+			return nil, apperror.ErrPrescriptionRedeemed
+		}
+
 		logrus.Error(err)
 		return nil, err
 	}
@@ -327,8 +339,11 @@ func (r *cartItemRepositoryImpl) LockRow(ctx context.Context, cart []*entity.Car
 					ci.pharmacy_drug_id, 
 					ci.quantity, 
 					ci.is_prescripted,
+					ci.prescription_id,
 					pd.price,
 					pd.pharmacy_id,
+					d.drug_id,
+					d.classification,
 					d.weight
 					FROM
 					cart_items ci  
@@ -373,8 +388,11 @@ func (r *cartItemRepositoryImpl) LockRow(ctx context.Context, cart []*entity.Car
 			&cartItemData.PharmacyDrugID,
 			&cartItemData.Quantity,
 			&cartItemData.IsPrescripted,
+			&cartItemData.PrescriptionID,
 			&cartItemData.Price,
 			&cartItemData.PharmacyDrug.PharmacyID,
+			&cartItemData.PharmacyDrug.Drug.ID,
+			&cartItemData.PharmacyDrug.Drug.Classification,
 			&cartItemData.PharmacyDrug.Drug.Weight,
 		)
 		numeric++
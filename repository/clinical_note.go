@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+)
+
+type ClinicalNoteRepository interface {
+	SelectOneByTelemedicineID(ctx context.Context, telemedicineID uint) (*entity.ClinicalNote, error)
+	UpsertOne(ctx context.Context, note entity.ClinicalNote) (*entity.ClinicalNote, error)
+	DeleteDiagnosesByNoteID(ctx context.Context, noteID uint) error
+	InsertManyDiagnoses(ctx context.Context, noteID uint, diagnoses []entity.ClinicalNoteDiagnosis) error
+}
+
+type clinicalNoteRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewClinicalNoteRepository(db transaction.DBTransaction) *clinicalNoteRepositoryImpl {
+	return &clinicalNoteRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *clinicalNoteRepositoryImpl) SelectOneByTelemedicineID(ctx context.Context, telemedicineID uint) (*entity.ClinicalNote, error) {
+	q := `
+		SELECT
+			clinical_note_id, telemedicine_id, subjective, objective, assessment, plan, created_at, updated_at
+		FROM
+			clinical_notes
+		WHERE
+			telemedicine_id = $1
+		AND
+			deleted_at IS NULL
+	`
+
+	var scan entity.ClinicalNote
+	err := r.db.QueryRowContext(ctx, q, telemedicineID).Scan(
+		&scan.ID,
+		&scan.TelemedicineID,
+		&scan.Subjective,
+		&scan.Objective,
+		&scan.Assessment,
+		&scan.Plan,
+		&scan.CreatedAt,
+		&scan.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	diagnoses, err := r.selectAllDiagnosesByNoteID(ctx, scan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scan.Diagnoses = diagnoses
+	return &scan, nil
+}
+
+func (r *clinicalNoteRepositoryImpl) UpsertOne(ctx context.Context, note entity.ClinicalNote) (*entity.ClinicalNote, error) {
+	q := `
+		INSERT INTO
+			clinical_notes (telemedicine_id, subjective, objective, assessment, plan)
+		VALUES
+			($1, $2, $3, $4, $5)
+		ON CONFLICT (telemedicine_id) DO UPDATE SET
+			subjective = EXCLUDED.subjective,
+			objective = EXCLUDED.objective,
+			assessment = EXCLUDED.assessment,
+			plan = EXCLUDED.plan,
+			updated_at = now(),
+			deleted_at = NULL
+		RETURNING
+			clinical_note_id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, q,
+		note.TelemedicineID,
+		note.Subjective,
+		note.Objective,
+		note.Assessment,
+		note.Plan,
+	).Scan(
+		&note.ID,
+		&note.CreatedAt,
+		&note.UpdatedAt,
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &note, nil
+}
+
+func (r *clinicalNoteRepositoryImpl) DeleteDiagnosesByNoteID(ctx context.Context, noteID uint) error {
+	q := `
+		DELETE FROM clinical_note_diagnoses
+		WHERE
+			clinical_note_id = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, q, noteID); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *clinicalNoteRepositoryImpl) InsertManyDiagnoses(ctx context.Context, noteID uint, diagnoses []entity.ClinicalNoteDiagnosis) error {
+	args := make([]any, 0)
+	q := `
+		INSERT INTO
+			clinical_note_diagnoses (clinical_note_id, icd10_code, is_primary)
+		VALUES
+			%s
+	`
+
+	insertData := make([]string, 0)
+	for _, diagnosis := range diagnoses {
+		argsLen := len(args)
+		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3))
+		args = append(args, noteID, diagnosis.ICD10Code.Code, diagnosis.IsPrimary)
+	}
+
+	_, err := r.db.ExecContext(ctx, fmt.Sprintf(q, strings.Join(insertData, ",")), args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constant.ForeignKeyViolationCode {
+			return apperror.ErrICD10CodeNotFound
+		}
+
+		if errors.As(err, &pgErr) && pgErr.Code == constant.UniqueViolationCode {
+			return apperror.ErrDuplicateDiagnosis
+		}
+
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *clinicalNoteRepositoryImpl) selectAllDiagnosesByNoteID(ctx context.Context, noteID uint) ([]entity.ClinicalNoteDiagnosis, error) {
+	q := `
+		SELECT
+			ic.code, ic.description, cnd.is_primary
+		FROM
+			clinical_note_diagnoses cnd
+		JOIN
+			icd10_codes ic ON ic.code = cnd.icd10_code
+		WHERE
+			cnd.clinical_note_id = $1
+		ORDER BY
+			cnd.is_primary DESC, cnd.clinical_note_diagnosis_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, noteID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	diagnoses := make([]entity.ClinicalNoteDiagnosis, 0)
+	for rows.Next() {
+		var scan entity.ClinicalNoteDiagnosis
+		if err := rows.Scan(&scan.ICD10Code.Code, &scan.ICD10Code.Description, &scan.IsPrimary); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		diagnoses = append(diagnoses, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return diagnoses, nil
+}
@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type DependentRepository interface {
+	SelectAllByUserID(ctx context.Context, userID uint) ([]entity.Dependent, error)
+	SelectOneByID(ctx context.Context, id uint, userID uint) (*entity.Dependent, error)
+	SelectOneIncludeDeletedByID(ctx context.Context, id uint) (*entity.Dependent, error)
+	InsertOne(ctx context.Context, dependent entity.Dependent) (*entity.Dependent, error)
+	UpdateOne(ctx context.Context, dependent entity.Dependent) (*entity.Dependent, error)
+	DeleteOne(ctx context.Context, id uint, userID uint) error
+}
+
+type dependentRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewDependentRepository(db transaction.DBTransaction) *dependentRepositoryImpl {
+	return &dependentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *dependentRepositoryImpl) SelectAllByUserID(ctx context.Context, userID uint) ([]entity.Dependent, error) {
+	q := `
+		SELECT
+			dependent_id, user_id, dependent_name, date_of_birth, gender, relationship, created_at, updated_at
+		FROM
+			dependents
+		WHERE
+			user_id = $1
+		AND
+			deleted_at IS NULL
+		ORDER BY
+			dependent_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	dependents := make([]entity.Dependent, 0)
+	for rows.Next() {
+		var scan entity.Dependent
+		if err := rows.Scan(
+			&scan.ID,
+			&scan.UserID,
+			&scan.Name,
+			&scan.DateOfBirth,
+			&scan.Gender,
+			&scan.Relationship,
+			&scan.CreatedAt,
+			&scan.UpdatedAt,
+		); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		dependents = append(dependents, scan)
+	}
+
+	return dependents, nil
+}
+
+func (r *dependentRepositoryImpl) SelectOneByID(ctx context.Context, id uint, userID uint) (*entity.Dependent, error) {
+	q := `
+		SELECT
+			dependent_id, user_id, dependent_name, date_of_birth, gender, relationship, created_at, updated_at
+		FROM
+			dependents
+		WHERE
+			dependent_id = $1
+		AND
+			user_id = $2
+		AND
+			deleted_at IS NULL
+	`
+
+	var scan entity.Dependent
+	err := r.db.QueryRowContext(ctx, q, id, userID).Scan(
+		&scan.ID,
+		&scan.UserID,
+		&scan.Name,
+		&scan.DateOfBirth,
+		&scan.Gender,
+		&scan.Relationship,
+		&scan.CreatedAt,
+		&scan.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *dependentRepositoryImpl) InsertOne(ctx context.Context, dependent entity.Dependent) (*entity.Dependent, error) {
+	q := `
+		INSERT INTO
+			dependents (user_id, dependent_name, date_of_birth, gender, relationship)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+			dependent_id, user_id, dependent_name, date_of_birth, gender, relationship, created_at, updated_at
+	`
+
+	var scan entity.Dependent
+	err := r.db.QueryRowContext(ctx, q,
+		dependent.UserID,
+		dependent.Name,
+		dependent.DateOfBirth,
+		dependent.Gender,
+		dependent.Relationship,
+	).Scan(
+		&scan.ID,
+		&scan.UserID,
+		&scan.Name,
+		&scan.DateOfBirth,
+		&scan.Gender,
+		&scan.Relationship,
+		&scan.CreatedAt,
+		&scan.UpdatedAt,
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *dependentRepositoryImpl) UpdateOne(ctx context.Context, dependent entity.Dependent) (*entity.Dependent, error) {
+	q := `
+		UPDATE
+			dependents
+		SET
+			dependent_name = $1,
+			date_of_birth = $2,
+			gender = $3,
+			relationship = $4,
+			updated_at = current_timestamp
+		WHERE
+			dependent_id = $5
+		AND
+			user_id = $6
+		AND
+			deleted_at IS NULL
+		RETURNING
+			dependent_id, user_id, dependent_name, date_of_birth, gender, relationship, created_at, updated_at
+	`
+
+	var scan entity.Dependent
+	err := r.db.QueryRowContext(ctx, q,
+		dependent.Name,
+		dependent.DateOfBirth,
+		dependent.Gender,
+		dependent.Relationship,
+		dependent.ID,
+		dependent.UserID,
+	).Scan(
+		&scan.ID,
+		&scan.UserID,
+		&scan.Name,
+		&scan.DateOfBirth,
+		&scan.Gender,
+		&scan.Relationship,
+		&scan.CreatedAt,
+		&scan.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *dependentRepositoryImpl) DeleteOne(ctx context.Context, id uint, userID uint) error {
+	q := `
+		UPDATE
+			dependents
+		SET
+			deleted_at = current_timestamp,
+			updated_at = current_timestamp
+		WHERE
+			dependent_id = $1
+		AND
+			user_id = $2
+		AND
+			deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, q, id, userID)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (r *dependentRepositoryImpl) SelectOneIncludeDeletedByID(ctx context.Context, id uint) (*entity.Dependent, error) {
+	q := `
+		SELECT
+			dependent_id, user_id, dependent_name, date_of_birth, gender, relationship, created_at, updated_at, deleted_at
+		FROM
+			dependents
+		WHERE
+			dependent_id = $1
+	`
+
+	var scan entity.Dependent
+	err := r.db.QueryRowContext(ctx, q, id).Scan(
+		&scan.ID,
+		&scan.UserID,
+		&scan.Name,
+		&scan.DateOfBirth,
+		&scan.Gender,
+		&scan.Relationship,
+		&scan.CreatedAt,
+		&scan.UpdatedAt,
+		&scan.DeletedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
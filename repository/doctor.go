@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
 	"Alice-Seahat-Healthcare/seahat-be/constant"
@@ -21,6 +22,9 @@ var (
 		"status":            "status",
 		"specialization_id": "specialization_id",
 		"created_at":        "created_at",
+		"rating":            "FLOOR(rating_average)",
+		"rating_average":    "rating_average",
+		"rating_count":      "rating_count",
 	}
 	docSearchColumn = []string{
 		"doctor_name",
@@ -39,6 +43,8 @@ type DoctorRepository interface {
 	UpdatePersonalByID(ctx context.Context, doctor entity.Doctor) error
 	UpdatePassword(ctx context.Context, id uint, password string) error
 	UpdateStatus(ctx context.Context, doctor entity.Doctor) error
+	UpdateOfflineByIdle(ctx context.Context, idleTimeout time.Duration) (int64, error)
+	UpdateRatingByID(ctx context.Context, doctorID uint) error
 	DeleteByID(ctx context.Context, doctorID uint) error
 }
 
@@ -126,6 +132,8 @@ func (r *doctorRepositoryImpl) SelectOneByID(ctx context.Context, id uint) (*ent
 			price,
 			status,
 			years_of_experience,
+			rating_average,
+			rating_count,
 			created_at 
 		FROM 
 			doctors
@@ -149,6 +157,8 @@ func (r *doctorRepositoryImpl) SelectOneByID(ctx context.Context, id uint) (*ent
 		&scan.Price,
 		&scan.Status,
 		&scan.YearsOfExperience,
+		&scan.RatingAverage,
+		&scan.RatingCount,
 		&scan.CreatedAt,
 	)
 
@@ -181,6 +191,8 @@ func (r *doctorRepositoryImpl) SelectOneWithSpecializationByID(ctx context.Conte
 			d.price,
 			d.status,
 			d.years_of_experience,
+			d.rating_average,
+			d.rating_count,
 			d.created_at,
 			s.specialization_id,
 			s.specialization_name,
@@ -208,6 +220,8 @@ func (r *doctorRepositoryImpl) SelectOneWithSpecializationByID(ctx context.Conte
 		&scan.Price,
 		&scan.Status,
 		&scan.YearsOfExperience,
+		&scan.RatingAverage,
+		&scan.RatingCount,
 		&scan.CreatedAt,
 		&scan.Specialization.ID,
 		&scan.Specialization.Name,
@@ -398,6 +412,8 @@ func (r *doctorRepositoryImpl) SelectAll(ctx context.Context, clc *entity.Collec
 		price,
 		status,
 		years_of_experience,
+		rating_average,
+		rating_count,
 		created_at
 	`
 	advanceQuery := `
@@ -446,6 +462,8 @@ func (r *doctorRepositoryImpl) SelectAll(ctx context.Context, clc *entity.Collec
 			&doctor.Price,
 			&doctor.Status,
 			&doctor.YearsOfExperience,
+			&doctor.RatingAverage,
+			&doctor.RatingCount,
 			&doctor.CreatedAt,
 		)
 
@@ -571,3 +589,105 @@ func (r *doctorRepositoryImpl) DeleteByID(ctx context.Context, doctorID uint) er
 
 	return nil
 }
+
+func (r *doctorRepositoryImpl) UpdateOfflineByIdle(ctx context.Context, idleTimeout time.Duration) (int64, error) {
+	q := `
+		UPDATE doctors d
+		SET
+			status = $1,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			d.status = $2
+		AND
+			COALESCE(d.updated_at, d.created_at) <= now() - ($3::INT * INTERVAL '1 second')
+		AND
+			d.deleted_at IS NULL
+		AND
+			NOT EXISTS (
+				SELECT
+					1
+				FROM
+					message_bubbles mb
+				JOIN
+					telemedicines t ON t.telemedicine_id = mb.telemedicine_id
+				WHERE
+					t.doctor_id = d.doctor_id
+				AND
+					mb.sender_type = $4
+				AND
+					mb.created_at > now() - ($3::INT * INTERVAL '1 second')
+				AND
+					mb.deleted_at IS NULL
+			)
+	`
+
+	result, err := r.db.ExecContext(ctx, q, constant.StatusOffline, constant.StatusOnline, int(idleTimeout.Seconds()), constant.Doctor)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *doctorRepositoryImpl) UpdateRatingByID(ctx context.Context, doctorID uint) error {
+	lockQ := `
+		SELECT
+			doctor_id
+		FROM
+			doctors
+		WHERE
+			doctor_id = $1
+		FOR UPDATE
+	`
+
+	var lockedID uint
+	if err := r.db.QueryRowContext(ctx, lockQ, doctorID).Scan(&lockedID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return err
+	}
+
+	q := `
+		UPDATE doctors d
+		SET
+			rating_average = COALESCE(r.average, 0),
+			rating_count = r.total
+		FROM (
+			SELECT
+				AVG(rating) AS average,
+				COUNT(*) AS total
+			FROM
+				doctor_reviews
+			WHERE
+				doctor_id = $1
+			AND
+				is_hidden = FALSE
+			AND
+				deleted_at IS NULL
+		) r
+		WHERE
+			d.doctor_id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, q, doctorID)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
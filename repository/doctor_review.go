@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	doctorReviewColumnAlias = map[string]string{
+		"rating":     "dr.rating",
+		"doctor_id":  "dr.doctor_id",
+		"is_hidden":  "dr.is_hidden",
+		"created_at": "dr.created_at",
+	}
+	doctorReviewSearchColumn = []string{
+		"dr.review",
+	}
+)
+
+const doctorReviewSelectColumns = `
+	dr.doctor_review_id, dr.telemedicine_id, dr.doctor_id, dr.user_id, u.user_name, u.photo_url, dr.rating, dr.review, dr.is_hidden, dr.created_at
+`
+
+type DoctorReviewRepository interface {
+	SelectAll(ctx context.Context, clc *entity.Collection) ([]entity.DoctorReview, error)
+	SelectAllVisibleByDoctorID(ctx context.Context, doctorID uint, clc *entity.Collection) ([]entity.DoctorReview, error)
+	InsertOne(ctx context.Context, review entity.DoctorReview) (*entity.DoctorReview, error)
+	UpdateHiddenByID(ctx context.Context, id uint, isHidden bool) (*entity.DoctorReview, error)
+}
+
+type doctorReviewRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewDoctorReviewRepository(db transaction.DBTransaction) *doctorReviewRepositoryImpl {
+	return &doctorReviewRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *doctorReviewRepositoryImpl) SelectAll(ctx context.Context, clc *entity.Collection) ([]entity.DoctorReview, error) {
+	advanceQuery := `
+			doctor_reviews dr
+		JOIN
+			users u ON u.user_id = dr.user_id
+		WHERE
+		%s
+		%s
+	`
+
+	search := utils.BuildSearchQuery(doctorReviewSearchColumn, clc)
+	orderBy := utils.BuildSortQuery(doctorReviewColumnAlias, clc.Sort, "dr.created_at desc")
+	filter := utils.BuildFilterQuery(doctorReviewColumnAlias, clc, "dr.deleted_at IS NULL")
+
+	query := utils.BuildQuery(r.db, utils.PaginateQuery{
+		SelectColumns: doctorReviewSelectColumns,
+		AdvanceQuery:  fmt.Sprintf(advanceQuery, filter, search),
+		OrderQuery:    orderBy,
+	}, clc)
+
+	return r.selectAll(ctx, query, clc.Args...)
+}
+
+func (r *doctorReviewRepositoryImpl) SelectAllVisibleByDoctorID(ctx context.Context, doctorID uint, clc *entity.Collection) ([]entity.DoctorReview, error) {
+	advanceQuery := `
+			doctor_reviews dr
+		JOIN
+			users u ON u.user_id = dr.user_id
+		WHERE
+			dr.doctor_id = $1 AND
+			dr.is_hidden = FALSE AND
+		%s
+	`
+
+	clc.Args = append(clc.Args, doctorID)
+
+	orderBy := utils.BuildSortQuery(doctorReviewColumnAlias, clc.Sort, "dr.created_at desc")
+	filter := utils.BuildFilterQuery(doctorReviewColumnAlias, clc, "dr.deleted_at IS NULL")
+
+	query := utils.BuildQuery(r.db, utils.PaginateQuery{
+		SelectColumns: doctorReviewSelectColumns,
+		AdvanceQuery:  fmt.Sprintf(advanceQuery, filter),
+		OrderQuery:    orderBy,
+	}, clc)
+
+	return r.selectAll(ctx, query, clc.Args...)
+}
+
+func (r *doctorReviewRepositoryImpl) InsertOne(ctx context.Context, review entity.DoctorReview) (*entity.DoctorReview, error) {
+	q := `
+		INSERT INTO doctor_reviews
+			(telemedicine_id, doctor_id, user_id, rating, review)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+			doctor_review_id, telemedicine_id, doctor_id, user_id, rating, review, is_hidden, created_at
+	`
+
+	var scan entity.DoctorReview
+	err := r.db.QueryRowContext(ctx, q,
+		review.TelemedicineID,
+		review.DoctorID,
+		review.User.ID,
+		review.Rating,
+		review.Review,
+	).Scan(
+		&scan.ID,
+		&scan.TelemedicineID,
+		&scan.DoctorID,
+		&scan.User.ID,
+		&scan.Rating,
+		&scan.Review,
+		&scan.IsHidden,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constant.UniqueViolationCode {
+			return nil, apperror.ErrReviewAlreadyExists
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *doctorReviewRepositoryImpl) UpdateHiddenByID(ctx context.Context, id uint, isHidden bool) (*entity.DoctorReview, error) {
+	q := `
+		UPDATE
+			doctor_reviews
+		SET
+			is_hidden = $1,
+			updated_at = now()
+		WHERE
+			doctor_review_id = $2
+		AND
+			deleted_at IS NULL
+		RETURNING
+			doctor_review_id, telemedicine_id, doctor_id, user_id, rating, review, is_hidden, created_at
+	`
+
+	var scan entity.DoctorReview
+	err := r.db.QueryRowContext(ctx, q, isHidden, id).Scan(
+		&scan.ID,
+		&scan.TelemedicineID,
+		&scan.DoctorID,
+		&scan.User.ID,
+		&scan.Rating,
+		&scan.Review,
+		&scan.IsHidden,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *doctorReviewRepositoryImpl) selectAll(ctx context.Context, query string, args ...any) ([]entity.DoctorReview, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	reviews := make([]entity.DoctorReview, 0)
+	for rows.Next() {
+		var scan entity.DoctorReview
+		err := rows.Scan(
+			&scan.ID,
+			&scan.TelemedicineID,
+			&scan.DoctorID,
+			&scan.User.ID,
+			&scan.User.Name,
+			&scan.User.PhotoURL,
+			&scan.Rating,
+			&scan.Review,
+			&scan.IsHidden,
+			&scan.CreatedAt,
+		)
+
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		reviews = append(reviews, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+)
+
+type DoctorScheduleRepository interface {
+	SelectAllByDoctorID(ctx context.Context, doctorID uint) ([]entity.DoctorSchedule, error)
+	SelectAllByDoctorIDAndDay(ctx context.Context, doctorID uint, dayOfWeek int) ([]entity.DoctorSchedule, error)
+	InsertOne(ctx context.Context, schedule entity.DoctorSchedule) (*entity.DoctorSchedule, error)
+	DeleteOne(ctx context.Context, id uint, doctorID uint) error
+}
+
+type doctorScheduleRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewDoctorScheduleRepository(db transaction.DBTransaction) *doctorScheduleRepositoryImpl {
+	return &doctorScheduleRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *doctorScheduleRepositoryImpl) SelectAllByDoctorID(ctx context.Context, doctorID uint) ([]entity.DoctorSchedule, error) {
+	q := `
+		SELECT
+			doctor_schedule_id, doctor_id, day_of_week, start_time, end_time, slot_duration, created_at
+		FROM
+			doctor_schedules
+		WHERE
+			doctor_id = $1
+		AND
+			deleted_at IS NULL
+		ORDER BY
+			day_of_week, start_time
+	`
+
+	return r.selectAll(ctx, q, doctorID)
+}
+
+func (r *doctorScheduleRepositoryImpl) SelectAllByDoctorIDAndDay(ctx context.Context, doctorID uint, dayOfWeek int) ([]entity.DoctorSchedule, error) {
+	q := `
+		SELECT
+			doctor_schedule_id, doctor_id, day_of_week, start_time, end_time, slot_duration, created_at
+		FROM
+			doctor_schedules
+		WHERE
+			doctor_id = $1
+		AND
+			day_of_week = $2
+		AND
+			deleted_at IS NULL
+		ORDER BY
+			start_time
+	`
+
+	return r.selectAll(ctx, q, doctorID, dayOfWeek)
+}
+
+func (r *doctorScheduleRepositoryImpl) InsertOne(ctx context.Context, schedule entity.DoctorSchedule) (*entity.DoctorSchedule, error) {
+	q := `
+		INSERT INTO doctor_schedules
+			(doctor_id, day_of_week, start_time, end_time, slot_duration)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+			doctor_schedule_id, doctor_id, day_of_week, start_time, end_time, slot_duration, created_at
+	`
+
+	var scan entity.DoctorSchedule
+	err := r.db.QueryRowContext(ctx, q,
+		schedule.DoctorID,
+		schedule.DayOfWeek,
+		schedule.StartTime,
+		schedule.EndTime,
+		schedule.SlotDuration,
+	).Scan(
+		&scan.ID,
+		&scan.DoctorID,
+		&scan.DayOfWeek,
+		&scan.StartTime,
+		&scan.EndTime,
+		&scan.SlotDuration,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constant.ExclusionViolationCode {
+			return nil, apperror.ErrScheduleOverlap
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *doctorScheduleRepositoryImpl) DeleteOne(ctx context.Context, id uint, doctorID uint) error {
+	q := `
+		UPDATE
+			doctor_schedules
+		SET
+			deleted_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			doctor_schedule_id = $1
+		AND
+			doctor_id = $2
+		AND
+			deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, q, id, doctorID)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (r *doctorScheduleRepositoryImpl) selectAll(ctx context.Context, q string, args ...any) ([]entity.DoctorSchedule, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	schedules := make([]entity.DoctorSchedule, 0)
+	for rows.Next() {
+		var scan entity.DoctorSchedule
+		if err := rows.Scan(
+			&scan.ID,
+			&scan.DoctorID,
+			&scan.DayOfWeek,
+			&scan.StartTime,
+			&scan.EndTime,
+			&scan.SlotDuration,
+			&scan.CreatedAt,
+		); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		schedules = append(schedules, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return schedules, nil
+}
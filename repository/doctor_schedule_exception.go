@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type DoctorScheduleExceptionRepository interface {
+	SelectAllUpcomingByDoctorID(ctx context.Context, doctorID uint) ([]entity.DoctorScheduleException, error)
+	SelectAllByDoctorIDAndDate(ctx context.Context, doctorID uint, date time.Time) ([]entity.DoctorScheduleException, error)
+	InsertOne(ctx context.Context, exception entity.DoctorScheduleException) (*entity.DoctorScheduleException, error)
+	DeleteOne(ctx context.Context, id uint, doctorID uint) error
+}
+
+type doctorScheduleExceptionRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewDoctorScheduleExceptionRepository(db transaction.DBTransaction) *doctorScheduleExceptionRepositoryImpl {
+	return &doctorScheduleExceptionRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *doctorScheduleExceptionRepositoryImpl) SelectAllUpcomingByDoctorID(ctx context.Context, doctorID uint) ([]entity.DoctorScheduleException, error) {
+	q := `
+		SELECT
+			doctor_schedule_exception_id, doctor_id, exception_date, start_time, end_time, reason, created_at
+		FROM
+			doctor_schedule_exceptions
+		WHERE
+			doctor_id = $1
+		AND
+			exception_date >= CURRENT_DATE
+		AND
+			deleted_at IS NULL
+		ORDER BY
+			exception_date, start_time
+	`
+
+	return r.selectAll(ctx, q, doctorID)
+}
+
+func (r *doctorScheduleExceptionRepositoryImpl) SelectAllByDoctorIDAndDate(ctx context.Context, doctorID uint, date time.Time) ([]entity.DoctorScheduleException, error) {
+	q := `
+		SELECT
+			doctor_schedule_exception_id, doctor_id, exception_date, start_time, end_time, reason, created_at
+		FROM
+			doctor_schedule_exceptions
+		WHERE
+			doctor_id = $1
+		AND
+			exception_date = $2
+		AND
+			deleted_at IS NULL
+	`
+
+	return r.selectAll(ctx, q, doctorID, date)
+}
+
+func (r *doctorScheduleExceptionRepositoryImpl) InsertOne(ctx context.Context, exception entity.DoctorScheduleException) (*entity.DoctorScheduleException, error) {
+	q := `
+		INSERT INTO doctor_schedule_exceptions
+			(doctor_id, exception_date, start_time, end_time, reason)
+		VALUES
+			($1, $2, $3, $4, $5)
+		RETURNING
+			doctor_schedule_exception_id, doctor_id, exception_date, start_time, end_time, reason, created_at
+	`
+
+	var scan entity.DoctorScheduleException
+	err := r.db.QueryRowContext(ctx, q,
+		exception.DoctorID,
+		exception.Date,
+		exception.StartTime,
+		exception.EndTime,
+		exception.Reason,
+	).Scan(
+		&scan.ID,
+		&scan.DoctorID,
+		&scan.Date,
+		&scan.StartTime,
+		&scan.EndTime,
+		&scan.Reason,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *doctorScheduleExceptionRepositoryImpl) DeleteOne(ctx context.Context, id uint, doctorID uint) error {
+	q := `
+		UPDATE
+			doctor_schedule_exceptions
+		SET
+			deleted_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			doctor_schedule_exception_id = $1
+		AND
+			doctor_id = $2
+		AND
+			deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, q, id, doctorID)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (r *doctorScheduleExceptionRepositoryImpl) selectAll(ctx context.Context, q string, args ...any) ([]entity.DoctorScheduleException, error) {
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	exceptions := make([]entity.DoctorScheduleException, 0)
+	for rows.Next() {
+		var scan entity.DoctorScheduleException
+		if err := rows.Scan(
+			&scan.ID,
+			&scan.DoctorID,
+			&scan.Date,
+			&scan.StartTime,
+			&scan.EndTime,
+			&scan.Reason,
+			&scan.CreatedAt,
+		); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		exceptions = append(exceptions, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return exceptions, nil
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type DrugInteractionRepository interface {
+	SelectAllByDrugIDs(ctx context.Context, drugIDs []uint) ([]entity.DrugInteractionWarning, error)
+	UpsertMany(ctx context.Context, interactions []entity.DrugInteraction) error
+}
+
+type drugInteractionRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewDrugInteractionRepository(db transaction.DBTransaction) *drugInteractionRepositoryImpl {
+	return &drugInteractionRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *drugInteractionRepositoryImpl) SelectAllByDrugIDs(ctx context.Context, drugIDs []uint) ([]entity.DrugInteractionWarning, error) {
+	q := `
+		SELECT
+			di.drug_interaction_id,
+			di.ingredient_a,
+			di.ingredient_b,
+			di.severity,
+			di.description,
+			da.drug_id,
+			da.drug_name,
+			db.drug_id,
+			db.drug_name
+		FROM
+			drug_interactions di
+		JOIN
+			drugs da ON da.drug_id = ANY($1::int[])
+			AND lower(da.composition || ' ' || da.generic_name) LIKE '%' || lower(di.ingredient_a) || '%'
+		JOIN
+			drugs db ON db.drug_id = ANY($1::int[])
+			AND db.drug_id <> da.drug_id
+			AND lower(db.composition || ' ' || db.generic_name) LIKE '%' || lower(di.ingredient_b) || '%'
+		WHERE
+			di.deleted_at IS NULL
+		ORDER BY
+			CASE di.severity WHEN 'severe' THEN 1 WHEN 'moderate' THEN 2 ELSE 3 END,
+			di.drug_interaction_id
+	`
+
+	param := new(strings.Builder)
+	param.WriteString("{")
+
+	idsLength := len(drugIDs)
+
+	for index, id := range drugIDs {
+		param.WriteString(fmt.Sprint(id))
+
+		if index != idsLength-1 {
+			param.WriteString(",")
+		}
+	}
+
+	param.WriteString("}")
+
+	rows, err := r.db.QueryContext(ctx, q, param.String())
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	warnings := make([]entity.DrugInteractionWarning, 0)
+	for rows.Next() {
+		var scan entity.DrugInteractionWarning
+		if err := rows.Scan(
+			&scan.Interaction.ID,
+			&scan.Interaction.IngredientA,
+			&scan.Interaction.IngredientB,
+			&scan.Interaction.Severity,
+			&scan.Interaction.Description,
+			&scan.DrugA.ID,
+			&scan.DrugA.Name,
+			&scan.DrugB.ID,
+			&scan.DrugB.Name,
+		); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		warnings = append(warnings, scan)
+	}
+
+	return warnings, nil
+}
+
+func (r *drugInteractionRepositoryImpl) UpsertMany(ctx context.Context, interactions []entity.DrugInteraction) error {
+	args := make([]any, 0)
+	insertData := make([]string, 0)
+	for _, interaction := range interactions {
+		argsLen := len(args)
+		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3, argsLen+4))
+		args = append(args, interaction.IngredientA, interaction.IngredientB, interaction.Severity, interaction.Description)
+	}
+
+	q := `
+		INSERT INTO
+			drug_interactions (ingredient_a, ingredient_b, severity, description)
+		VALUES
+			%s
+		ON CONFLICT ((LEAST(lower(ingredient_a), lower(ingredient_b))), (GREATEST(lower(ingredient_a), lower(ingredient_b)))) WHERE deleted_at IS NULL
+		DO UPDATE SET
+			severity = EXCLUDED.severity,
+			description = EXCLUDED.description,
+			updated_at = NOW()
+	`
+
+	_, err := r.db.ExecContext(ctx, fmt.Sprintf(q, strings.Join(insertData, ",")), args...)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,368 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/sirupsen/logrus"
+)
+
+type HealthProfileRepository interface {
+	SelectOneByUserID(ctx context.Context, userID uint) (*entity.HealthProfile, error)
+	SelectAllAllergiesByUserID(ctx context.Context, userID uint) ([]entity.Allergy, error)
+	UpsertOne(ctx context.Context, profile entity.HealthProfile) (*entity.HealthProfile, error)
+	DeleteDetailsByUserID(ctx context.Context, userID uint) error
+	InsertManyAllergies(ctx context.Context, userID uint, allergies []entity.Allergy) error
+	InsertManyChronicConditions(ctx context.Context, userID uint, conditions []entity.ChronicCondition) error
+	InsertManyMedications(ctx context.Context, userID uint, medications []entity.Medication) error
+}
+
+type healthProfileRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewHealthProfileRepository(db transaction.DBTransaction) *healthProfileRepositoryImpl {
+	return &healthProfileRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *healthProfileRepositoryImpl) SelectOneByUserID(ctx context.Context, userID uint) (*entity.HealthProfile, error) {
+	q := `
+		SELECT
+			health_profile_id, user_id, weight_kg, height_cm, is_pregnant, created_at, updated_at
+		FROM
+			health_profiles
+		WHERE
+			user_id = $1
+		AND
+			deleted_at IS NULL
+	`
+
+	var scan entity.HealthProfile
+	err := r.db.QueryRowContext(ctx, q, userID).Scan(
+		&scan.ID,
+		&scan.UserID,
+		&scan.WeightKg,
+		&scan.HeightCm,
+		&scan.IsPregnant,
+		&scan.CreatedAt,
+		&scan.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	scan.Allergies, err = r.SelectAllAllergiesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scan.ChronicConditions, err = r.selectAllChronicConditionsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	scan.Medications, err = r.selectAllMedicationsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *healthProfileRepositoryImpl) SelectAllAllergiesByUserID(ctx context.Context, userID uint) ([]entity.Allergy, error) {
+	q := `
+		SELECT
+			user_allergy_id, allergen, reaction, severity
+		FROM
+			user_allergies
+		WHERE
+			user_id = $1
+		AND
+			deleted_at IS NULL
+		ORDER BY
+			user_allergy_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	allergies := make([]entity.Allergy, 0)
+	for rows.Next() {
+		var scan entity.Allergy
+		if err := rows.Scan(&scan.ID, &scan.Allergen, &scan.Reaction, &scan.Severity); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		allergies = append(allergies, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return allergies, nil
+}
+
+func (r *healthProfileRepositoryImpl) UpsertOne(ctx context.Context, profile entity.HealthProfile) (*entity.HealthProfile, error) {
+	q := `
+		INSERT INTO
+			health_profiles (user_id, weight_kg, height_cm, is_pregnant)
+		VALUES
+			($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET
+			weight_kg = EXCLUDED.weight_kg,
+			height_cm = EXCLUDED.height_cm,
+			is_pregnant = EXCLUDED.is_pregnant,
+			updated_at = now(),
+			deleted_at = NULL
+		RETURNING
+			health_profile_id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, q,
+		profile.UserID,
+		profile.WeightKg,
+		profile.HeightCm,
+		profile.IsPregnant,
+	).Scan(
+		&profile.ID,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+func (r *healthProfileRepositoryImpl) DeleteDetailsByUserID(ctx context.Context, userID uint) error {
+	q := `
+		WITH allergies AS (
+			UPDATE
+				user_allergies
+			SET
+				deleted_at = now(),
+				updated_at = now()
+			WHERE
+				user_id = $1
+			AND
+				deleted_at IS NULL
+		), conditions AS (
+			UPDATE
+				user_chronic_conditions
+			SET
+				deleted_at = now(),
+				updated_at = now()
+			WHERE
+				user_id = $1
+			AND
+				deleted_at IS NULL
+		)
+		UPDATE
+			user_medications
+		SET
+			deleted_at = now(),
+			updated_at = now()
+		WHERE
+			user_id = $1
+		AND
+			deleted_at IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, q, userID); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *healthProfileRepositoryImpl) InsertManyAllergies(ctx context.Context, userID uint, allergies []entity.Allergy) error {
+	args := make([]any, 0)
+	insertData := make([]string, 0)
+	for _, allergy := range allergies {
+		argsLen := len(args)
+		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3, argsLen+4))
+		args = append(args, userID, allergy.Allergen, allergy.Reaction, allergy.Severity)
+	}
+
+	q := `
+		INSERT INTO
+			user_allergies (user_id, allergen, reaction, severity)
+		VALUES
+			%s
+	`
+
+	return r.insertMany(ctx, fmt.Sprintf(q, strings.Join(insertData, ",")), args...)
+}
+
+func (r *healthProfileRepositoryImpl) InsertManyChronicConditions(ctx context.Context, userID uint, conditions []entity.ChronicCondition) error {
+	args := make([]any, 0)
+	insertData := make([]string, 0)
+	for _, condition := range conditions {
+		argsLen := len(args)
+		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3, argsLen+4))
+		args = append(args, userID, condition.Name, condition.ICD10Code, condition.Notes)
+	}
+
+	q := `
+		INSERT INTO
+			user_chronic_conditions (user_id, condition_name, icd10_code, notes)
+		VALUES
+			%s
+	`
+
+	err := r.insertMany(ctx, fmt.Sprintf(q, strings.Join(insertData, ",")), args...)
+	if errors.Is(err, apperror.ErrResourceNotFound) {
+		return apperror.ErrICD10CodeNotFound
+	}
+
+	return err
+}
+
+func (r *healthProfileRepositoryImpl) InsertManyMedications(ctx context.Context, userID uint, medications []entity.Medication) error {
+	args := make([]any, 0)
+	insertData := make([]string, 0)
+	for _, medication := range medications {
+		argsLen := len(args)
+		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3, argsLen+4, argsLen+5))
+		args = append(args, userID, medication.Name, medication.DrugID, medication.Dosage, medication.StartedAt)
+	}
+
+	q := `
+		INSERT INTO
+			user_medications (user_id, medication_name, drug_id, dosage, started_at)
+		VALUES
+			%s
+	`
+
+	err := r.insertMany(ctx, fmt.Sprintf(q, strings.Join(insertData, ",")), args...)
+	if errors.Is(err, apperror.ErrResourceNotFound) {
+		return apperror.ErrDrugNotExist
+	}
+
+	return err
+}
+
+func (r *healthProfileRepositoryImpl) insertMany(ctx context.Context, query string, args ...any) error {
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == constant.ForeignKeyViolationCode {
+			return apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *healthProfileRepositoryImpl) selectAllChronicConditionsByUserID(ctx context.Context, userID uint) ([]entity.ChronicCondition, error) {
+	q := `
+		SELECT
+			user_chronic_condition_id, condition_name, icd10_code, notes
+		FROM
+			user_chronic_conditions
+		WHERE
+			user_id = $1
+		AND
+			deleted_at IS NULL
+		ORDER BY
+			user_chronic_condition_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	conditions := make([]entity.ChronicCondition, 0)
+	for rows.Next() {
+		var scan entity.ChronicCondition
+		if err := rows.Scan(&scan.ID, &scan.Name, &scan.ICD10Code, &scan.Notes); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		conditions = append(conditions, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return conditions, nil
+}
+
+func (r *healthProfileRepositoryImpl) selectAllMedicationsByUserID(ctx context.Context, userID uint) ([]entity.Medication, error) {
+	q := `
+		SELECT
+			user_medication_id, medication_name, drug_id, dosage, started_at
+		FROM
+			user_medications
+		WHERE
+			user_id = $1
+		AND
+			deleted_at IS NULL
+		ORDER BY
+			user_medication_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, userID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	medications := make([]entity.Medication, 0)
+	for rows.Next() {
+		var scan entity.Medication
+		if err := rows.Scan(&scan.ID, &scan.Name, &scan.DrugID, &scan.Dosage, &scan.StartedAt); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		medications = append(medications, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return medications, nil
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	icd10CodeColumnAlias = map[string]string{
+		"code":        "code",
+		"description": "description",
+	}
+	icd10CodeSearchColumn = []string{
+		"code",
+		"description",
+	}
+)
+
+type ICD10CodeRepository interface {
+	SelectAll(ctx context.Context, clc *entity.Collection) ([]entity.ICD10Code, error)
+}
+
+type icd10CodeRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewICD10CodeRepository(db transaction.DBTransaction) *icd10CodeRepositoryImpl {
+	return &icd10CodeRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *icd10CodeRepositoryImpl) SelectAll(ctx context.Context, clc *entity.Collection) ([]entity.ICD10Code, error) {
+	selectColumns := `code, description`
+	advanceQuery := `
+			icd10_codes
+		WHERE
+		%s
+		%s
+	`
+
+	search := utils.BuildSearchQuery(icd10CodeSearchColumn, clc)
+	orderBy := utils.BuildSortQuery(icd10CodeColumnAlias, clc.Sort, "code asc")
+	filter := utils.BuildFilterQuery(icd10CodeColumnAlias, clc, "deleted_at IS NULL")
+
+	query := utils.BuildQuery(r.db, utils.PaginateQuery{
+		SelectColumns: selectColumns,
+		AdvanceQuery:  fmt.Sprintf(advanceQuery, filter, search),
+		OrderQuery:    orderBy,
+	}, clc)
+
+	rows, err := r.db.QueryContext(ctx, query, clc.Args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	codes := make([]entity.ICD10Code, 0)
+	for rows.Next() {
+		var scan entity.ICD10Code
+		if err := rows.Scan(&scan.Code, &scan.Description); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		codes = append(codes, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return codes, nil
+}
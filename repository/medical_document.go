@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type MedicalDocumentRepository interface {
+	SelectOneByCode(ctx context.Context, code string) (*entity.MedicalDocument, error)
+	InsertOne(ctx context.Context, document entity.MedicalDocument) (*entity.MedicalDocument, error)
+	UpdateSupersededByTelemedicineID(ctx context.Context, telemedicineID uint, documentType string) error
+}
+
+type medicalDocumentRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewMedicalDocumentRepository(db transaction.DBTransaction) *medicalDocumentRepositoryImpl {
+	return &medicalDocumentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *medicalDocumentRepositoryImpl) SelectOneByCode(ctx context.Context, code string) (*entity.MedicalDocument, error) {
+	q := `
+		SELECT
+			md.medical_document_id,
+			md.code,
+			md.type,
+			md.telemedicine_id,
+			u.user_id,
+			u.user_name,
+			d.doctor_id,
+			d.doctor_name,
+			s.specialization_name,
+			md.document_hash,
+			md.signature,
+			md.issued_at,
+			md.valid_until,
+			md.superseded_at,
+			md.created_at
+		FROM
+			medical_documents md
+		JOIN
+			telemedicines t ON t.telemedicine_id = md.telemedicine_id
+		JOIN
+			users u ON u.user_id = t.user_id
+		JOIN
+			doctors d ON d.doctor_id = t.doctor_id
+		JOIN
+			specializations s ON s.specialization_id = d.specialization_id
+		WHERE
+			md.code = $1
+		AND
+			md.deleted_at IS NULL
+	`
+
+	var scan entity.MedicalDocument
+	err := r.db.QueryRowContext(ctx, q, code).Scan(
+		&scan.ID,
+		&scan.Code,
+		&scan.Type,
+		&scan.Telemedicine.ID,
+		&scan.Telemedicine.User.ID,
+		&scan.Telemedicine.User.Name,
+		&scan.Telemedicine.Doctor.ID,
+		&scan.Telemedicine.Doctor.Name,
+		&scan.Telemedicine.Doctor.Specialization.Name,
+		&scan.Hash,
+		&scan.Signature,
+		&scan.IssuedAt,
+		&scan.ValidUntil,
+		&scan.SupersededAt,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *medicalDocumentRepositoryImpl) InsertOne(ctx context.Context, document entity.MedicalDocument) (*entity.MedicalDocument, error) {
+	q := `
+		INSERT INTO
+			medical_documents (code, type, telemedicine_id, document_hash, signature, issued_at, valid_until)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7)
+		RETURNING
+			medical_document_id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, q,
+		document.Code,
+		document.Type,
+		document.Telemedicine.ID,
+		document.Hash,
+		document.Signature,
+		document.IssuedAt,
+		document.ValidUntil,
+	).Scan(
+		&document.ID,
+		&document.CreatedAt,
+		&document.UpdatedAt,
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &document, nil
+}
+
+func (r *medicalDocumentRepositoryImpl) UpdateSupersededByTelemedicineID(ctx context.Context, telemedicineID uint, documentType string) error {
+	q := `
+		UPDATE
+			medical_documents
+		SET
+			superseded_at = now(),
+			updated_at = now()
+		WHERE
+			telemedicine_id = $1
+		AND
+			type = $2
+		AND
+			superseded_at IS NULL
+		AND
+			deleted_at IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, q, telemedicineID, documentType); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type MessageAttachmentRepository interface {
+	InsertOne(ctx context.Context, newAttachment entity.MessageAttachment) (*entity.MessageAttachment, error)
+}
+
+type messageAttachmentRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewMessageAttachmentRepository(db transaction.DBTransaction) *messageAttachmentRepositoryImpl {
+	return &messageAttachmentRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *messageAttachmentRepositoryImpl) InsertOne(ctx context.Context, newAttachment entity.MessageAttachment) (*entity.MessageAttachment, error) {
+	q := `
+		INSERT INTO message_attachments (
+			message_bubble_id,
+			mime_type,
+			size,
+			original_name
+		) VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			message_attachment_id,
+			message_bubble_id,
+			mime_type,
+			size,
+			original_name,
+			created_at
+	`
+
+	var scan entity.MessageAttachment
+	err := r.db.QueryRowContext(ctx, q,
+		newAttachment.MessageBubbleID,
+		newAttachment.MimeType,
+		newAttachment.Size,
+		newAttachment.OriginalName,
+	).Scan(
+		&scan.ID,
+		&scan.MessageBubbleID,
+		&scan.MimeType,
+		&scan.Size,
+		&scan.OriginalName,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
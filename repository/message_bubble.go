@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 
 	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
@@ -10,6 +11,7 @@ import (
 )
 
 type MessageBubbleRepository interface {
+	SelectAllByTelemedicineID(ctx context.Context, telemedicineID uint, cursor entity.Cursor) ([]entity.MessageBubble, error)
 	InsertOne(ctx context.Context, newMessageBubble entity.MessageBubble) (*entity.MessageBubble, error)
 }
 
@@ -23,6 +25,100 @@ func NewMessageBubblesRepository(db transaction.DBTransaction) *messageBubbleRep
 	}
 }
 
+func (r *messageBubbleRepositoryImpl) SelectAllByTelemedicineID(ctx context.Context, telemedicineID uint, cursor entity.Cursor) ([]entity.MessageBubble, error) {
+	q := `
+		SELECT
+			mb.message_bubble_id,
+			mb.telemedicine_id,
+			mb.sender_type,
+			mb.payload,
+			mb.payload_type,
+			mb.created_at,
+			ma.message_attachment_id,
+			ma.mime_type,
+			ma.size,
+			ma.original_name
+		FROM
+			message_bubbles mb
+		LEFT JOIN
+			message_attachments ma ON ma.message_bubble_id = mb.message_bubble_id AND ma.deleted_at IS NULL
+		WHERE
+			mb.telemedicine_id = $1
+		AND
+			mb.deleted_at IS NULL
+	`
+
+	args := []any{telemedicineID}
+	if cursor.Before != 0 {
+		args = append(args, cursor.Before)
+		q += fmt.Sprintf(" AND mb.message_bubble_id < $%d", len(args))
+	}
+
+	if cursor.After != 0 {
+		args = append(args, cursor.After)
+		q += fmt.Sprintf(" AND mb.message_bubble_id > $%d", len(args))
+	}
+
+	order := "DESC"
+	if cursor.After != 0 && cursor.Before == 0 {
+		order = "ASC"
+	}
+
+	args = append(args, cursor.Limit)
+	q += fmt.Sprintf(" ORDER BY mb.message_bubble_id %s LIMIT $%d", order, len(args))
+
+	rows, err := r.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := make([]entity.MessageBubble, 0)
+	for rows.Next() {
+		var scan entity.MessageBubble
+		var attachmentID *uint
+		var mimeType, originalName *string
+		var size *int64
+		if err := rows.Scan(
+			&scan.ID,
+			&scan.TelemedicineID,
+			&scan.SenderType,
+			&scan.Payload,
+			&scan.PayloadType,
+			&scan.CreatedAt,
+			&attachmentID,
+			&mimeType,
+			&size,
+			&originalName,
+		); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		if attachmentID != nil {
+			scan.Attachment = &entity.MessageAttachment{
+				ID:              *attachmentID,
+				MessageBubbleID: scan.ID,
+				MimeType:        *mimeType,
+				Size:            *size,
+				OriginalName:    *originalName,
+			}
+		}
+
+		results = append(results, scan)
+	}
+
+	if order == "DESC" {
+		for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+			results[i], results[j] = results[j], results[i]
+		}
+	}
+
+	return results, nil
+}
+
 func (r *messageBubbleRepositoryImpl) InsertOne(ctx context.Context, newMessageBubble entity.MessageBubble) (*entity.MessageBubble, error) {
 	q := `
 		INSERT INTO message_bubbles (
@@ -18,6 +18,19 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+var (
+	orderColumnAlias = map[string]string{
+		"status":      "o.status",
+		"pharmacy_id": "o.pharmacy_id",
+		"created_at":  "o.created_at",
+		"total_price": "o.total_price",
+	}
+	orderSearchColumn = []string{
+		"o.order_number::text",
+		"ph.pharmacy_name",
+	}
+)
+
 type OrderRepository interface {
 	InsertOrder(ctx context.Context, orders []entity.Order) ([]entity.Order, error)
 	UpdateOrderStatusByPaymentId(ctx context.Context, payment entity.Payment, futureStatus string, recentStatus string) ([]*entity.Order, error)
@@ -26,6 +39,13 @@ type OrderRepository interface {
 	PMUpdateOrderStatusByOrderId(ctx context.Context, order entity.Order, updateStatus string, pMId uint) (*entity.Order, error)
 	GetAllOrderByPharmacyManagerId(ctx context.Context, pharmacyManagerId uint) ([]*entity.Order, error)
 	SelecOrderStatusByOrderId(ctx context.Context, orderId uint) (*string, error)
+	UpdateCancelledByPaymentIDs(ctx context.Context, paymentIDs []uint) ([]uint, error)
+	UpdateDisputedByOrderId(ctx context.Context, order entity.Order, userId uint) (*entity.Order, error)
+	UpdateResolvedDisputeByOrderId(ctx context.Context, order entity.Order, updateStatus string, pMId *uint) (*entity.Order, error)
+	UpdateConfirmedBySentExpiry(ctx context.Context) ([]uint, error)
+	SelectOwnerByOrderId(ctx context.Context, orderId uint) (*entity.Order, error)
+	GetAllOrderByUserId(ctx context.Context, userId uint, clc *entity.Collection) ([]*entity.Order, error)
+	SelectOneByIdAndUserId(ctx context.Context, orderId uint, userId uint) (*entity.Order, error)
 }
 
 type orderRepositoryImpl struct {
@@ -209,8 +229,10 @@ func (r *orderRepositoryImpl) UpdateOrderStatusByOrderId(ctx context.Context, or
 }
 func (r *orderRepositoryImpl) PMUpdateOrderStatusByOrderId(ctx context.Context, order entity.Order, updateStatus string, pMId uint) (*entity.Order, error) {
 	futureStatus := ``
+	args := []any{updateStatus, order.Id, order.Status, pMId}
 	if updateStatus == constant.Sent {
-		futureStatus = `,finished_at=Now()+interval '7 days'`
+		futureStatus = `,finished_at=$5`
+		args = append(args, order.FinishedAt.Time)
 	}
 	if updateStatus == constant.Cancelled {
 		futureStatus = `,finished_at=Now()`
@@ -235,7 +257,7 @@ func (r *orderRepositoryImpl) PMUpdateOrderStatusByOrderId(ctx context.Context,
 			p.deleted_at is null
 		Returning o.order_id,o.status,o.pharmacy_id,o.order_number,o.total_price,o.shipment_price,o.shipment_method_name
 		`, futureStatus)
-	err := r.db.QueryRowContext(ctx, q, updateStatus, order.Id, order.Status, pMId).Scan(
+	err := r.db.QueryRowContext(ctx, q, args...).Scan(
 		&order.Id,
 		&order.Status,
 		&order.PharmacyId,
@@ -279,7 +301,7 @@ func (r *orderRepositoryImpl) SelectOrdersByOrderId(ctx context.Context, order e
 		od.order_id,
 		od.pharmacy_drug_id,
 		od.quantity,
-		pd.stock,
+		pd.stock - pd.reserved_stock,
 		pd.drug_id,
 	  	pd.pharmacy_id,
 		p.pharmacy_manager_id,
@@ -480,3 +502,389 @@ func (r *orderRepositoryImpl) GetAllOrderByPharmacyManagerId(ctx context.Context
 	return orders, nil
 
 }
+
+func (r *orderRepositoryImpl) UpdateCancelledByPaymentIDs(ctx context.Context, paymentIDs []uint) ([]uint, error) {
+	q := `
+		UPDATE
+			orders
+		SET
+			status = $1,
+			updated_at = now()
+		WHERE
+			payment_id = ANY($2::int[])
+		AND
+			status = $3
+		RETURNING
+			order_id
+	`
+
+	param := new(strings.Builder)
+	param.WriteString("{")
+
+	idsLength := len(paymentIDs)
+
+	for index, id := range paymentIDs {
+		param.WriteString(fmt.Sprint(id))
+
+		if index != idsLength-1 {
+			param.WriteString(",")
+		}
+	}
+
+	param.WriteString("}")
+
+	rows, err := r.db.QueryContext(ctx, q, constant.Cancelled, param.String(), constant.WaitingForPayment)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return scanOrderIds(rows)
+}
+
+func (r *orderRepositoryImpl) UpdateDisputedByOrderId(ctx context.Context, order entity.Order, userId uint) (*entity.Order, error) {
+	q := `
+		UPDATE
+			orders o
+		SET
+			status = $1,
+			disputed_at = now(),
+			dispute_reason = $2,
+			updated_at = now()
+		FROM
+			payments p
+		WHERE
+			o.order_id = $3
+		AND
+			o.status = $4
+		AND
+			o.finished_at > now()
+		AND
+			p.user_id = $5
+		AND
+			p.payment_id = o.payment_id
+		AND
+			o.deleted_at IS NULL
+		RETURNING
+			o.order_id, o.status, o.pharmacy_id, o.order_number, o.total_price, o.shipment_price, o.shipment_method_name, o.finished_at, o.dispute_reason
+	`
+
+	err := r.db.QueryRowContext(ctx, q, constant.Disputed, order.DisputeReason, order.Id, constant.Sent, userId).Scan(
+		&order.Id,
+		&order.Status,
+		&order.PharmacyId,
+		&order.OrderNumber,
+		&order.TotalPrice,
+		&order.ShipmentMethod.Price,
+		&order.ShipmentMethod.Name,
+		&order.FinishedAt,
+		&order.DisputeReason,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (r *orderRepositoryImpl) UpdateResolvedDisputeByOrderId(ctx context.Context, order entity.Order, updateStatus string, pMId *uint) (*entity.Order, error) {
+	q := `
+		UPDATE
+			orders o
+		SET
+			status = $1,
+			finished_at = now(),
+			updated_at = now()
+		FROM
+			pharmacies p
+		WHERE
+			o.order_id = $2
+		AND
+			o.status = $3
+		AND
+			o.pharmacy_id = p.pharmacy_id
+		AND
+			o.deleted_at IS NULL
+	`
+
+	if pMId != nil {
+		q += fmt.Sprintf("AND p.pharmacy_manager_id = %d", *pMId)
+	}
+
+	q += `
+		RETURNING
+			o.order_id, o.status, o.pharmacy_id, o.order_number, o.total_price, o.shipment_price, o.shipment_method_name, o.finished_at, o.dispute_reason
+	`
+
+	err := r.db.QueryRowContext(ctx, q, updateStatus, order.Id, constant.Disputed).Scan(
+		&order.Id,
+		&order.Status,
+		&order.PharmacyId,
+		&order.OrderNumber,
+		&order.TotalPrice,
+		&order.ShipmentMethod.Price,
+		&order.ShipmentMethod.Name,
+		&order.FinishedAt,
+		&order.DisputeReason,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (r *orderRepositoryImpl) UpdateConfirmedBySentExpiry(ctx context.Context) ([]uint, error) {
+	q := `
+		UPDATE
+			orders
+		SET
+			status = $1,
+			finished_at = now(),
+			updated_at = now()
+		WHERE
+			status = $2
+		AND
+			finished_at <= now()
+		AND
+			deleted_at IS NULL
+		RETURNING
+			order_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, constant.OrderConfirmed, constant.Sent)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return scanOrderIds(rows)
+}
+
+func (r *orderRepositoryImpl) SelectOwnerByOrderId(ctx context.Context, orderId uint) (*entity.Order, error) {
+	q := `
+		SELECT
+			o.order_id, o.status, o.pharmacy_id, py.user_id, ph.pharmacy_manager_id
+		FROM
+			orders o
+		JOIN
+			payments py ON py.payment_id = o.payment_id
+		JOIN
+			pharmacies ph ON ph.pharmacy_id = o.pharmacy_id
+		WHERE
+			o.order_id = $1
+		AND
+			o.deleted_at IS NULL
+	`
+
+	order := entity.Order{Payment: &entity.Payment{}, Pharmacy: &entity.Pharmacy{}}
+
+	err := r.db.QueryRowContext(ctx, q, orderId).Scan(
+		&order.Id,
+		&order.Status,
+		&order.PharmacyId,
+		&order.Payment.UserId,
+		&order.Pharmacy.ManagerID,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (r *orderRepositoryImpl) GetAllOrderByUserId(ctx context.Context, userId uint, clc *entity.Collection) ([]*entity.Order, error) {
+	selectColumns := `
+		o.order_id,
+		o.order_number,
+		o.status,
+		o.pharmacy_id,
+		ph.pharmacy_name,
+		o.shipment_method_name,
+		o.shipment_price,
+		o.total_price,
+		o.finished_at,
+		o.created_at,
+		py.payment_id,
+		py.payment_number,
+		py.payment_method
+	`
+	advanceQuery := `
+			orders o
+		JOIN
+			payments py ON py.payment_id = o.payment_id
+		JOIN
+			pharmacies ph ON ph.pharmacy_id = o.pharmacy_id
+		WHERE
+			py.user_id = $1
+		AND
+		%s
+		%s
+	`
+
+	clc.Args = append(clc.Args, userId)
+
+	search := utils.BuildSearchQuery(orderSearchColumn, clc)
+	orderBy := utils.BuildSortQuery(orderColumnAlias, clc.Sort, "o.created_at desc, o.order_id desc")
+	filter := utils.BuildFilterQuery(orderColumnAlias, clc, "o.deleted_at is null")
+
+	query := utils.BuildQuery(r.db, utils.PaginateQuery{
+		SelectColumns: selectColumns,
+		AdvanceQuery:  fmt.Sprintf(advanceQuery, filter, search),
+		OrderQuery:    orderBy,
+	}, clc)
+
+	rows, err := r.db.QueryContext(ctx, query, clc.Args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	orders := make([]*entity.Order, 0)
+	for rows.Next() {
+		o := &entity.Order{Payment: &entity.Payment{}, Pharmacy: &entity.Pharmacy{}}
+		err := rows.Scan(
+			&o.Id,
+			&o.OrderNumber,
+			&o.Status,
+			&o.PharmacyId,
+			&o.Pharmacy.Name,
+			&o.ShipmentMethod.Name,
+			&o.ShipmentMethod.Price,
+			&o.TotalPrice,
+			&o.FinishedAt,
+			&o.CreatedAt,
+			&o.Payment.Id,
+			&o.Payment.Number,
+			&o.Payment.Method,
+		)
+
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		o.Pharmacy.ID = o.PharmacyId
+		o.Payment.UserId = userId
+		orders = append(orders, o)
+	}
+
+	if err = rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (r *orderRepositoryImpl) SelectOneByIdAndUserId(ctx context.Context, orderId uint, userId uint) (*entity.Order, error) {
+	q := `
+		SELECT
+			o.order_id,
+			o.order_number,
+			o.status,
+			o.pharmacy_id,
+			ph.pharmacy_name,
+			o.shipment_method_name,
+			o.shipment_price,
+			o.total_price,
+			o.finished_at,
+			o.disputed_at,
+			o.dispute_reason,
+			o.created_at,
+			py.payment_id,
+			py.payment_number,
+			py.payment_method,
+			py.full_user_address
+		FROM
+			orders o
+		JOIN
+			payments py ON py.payment_id = o.payment_id
+		JOIN
+			pharmacies ph ON ph.pharmacy_id = o.pharmacy_id
+		WHERE
+			o.order_id = $1
+		AND
+			py.user_id = $2
+		AND
+			o.deleted_at IS NULL
+	`
+
+	o := entity.Order{Payment: &entity.Payment{}, Pharmacy: &entity.Pharmacy{}}
+
+	err := r.db.QueryRowContext(ctx, q, orderId, userId).Scan(
+		&o.Id,
+		&o.OrderNumber,
+		&o.Status,
+		&o.PharmacyId,
+		&o.Pharmacy.Name,
+		&o.ShipmentMethod.Name,
+		&o.ShipmentMethod.Price,
+		&o.TotalPrice,
+		&o.FinishedAt,
+		&o.DisputedAt,
+		&o.DisputeReason,
+		&o.CreatedAt,
+		&o.Payment.Id,
+		&o.Payment.Number,
+		&o.Payment.Method,
+		&o.Payment.FullUserAddress,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	o.Pharmacy.ID = o.PharmacyId
+	o.Payment.UserId = userId
+	return &o, nil
+}
+
+func scanOrderIds(rows *sql.Rows) ([]uint, error) {
+	defer rows.Close()
+
+	orderIds := make([]uint, 0)
+
+	for rows.Next() {
+		var id uint
+
+		if err := rows.Scan(&id); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		orderIds = append(orderIds, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return orderIds, nil
+}
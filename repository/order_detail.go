@@ -16,6 +16,9 @@ import (
 type OrderDetailRepository interface {
 	InsertOrderDetail(ctx context.Context, cart []*entity.CartItem, orderId uint) ([]*entity.OrderDetail, error)
 	SelectOrderDetailByOrderId(ctx context.Context, orderId uint) ([]*entity.OrderDetail, error)
+	SelectAllJoinDrugByOrderId(ctx context.Context, orderId uint) ([]*entity.OrderDetail, error)
+	UpdateStockReservedByOrderId(ctx context.Context, orderId uint) error
+	UpdateStockReleasedByOrderIds(ctx context.Context, orderIds []uint) ([]*entity.OrderDetail, error)
 }
 
 type orderDetailRepositoryImpl struct {
@@ -145,3 +148,153 @@ func (r *orderDetailRepositoryImpl) SelectOrderDetailByOrderId(ctx context.Conte
 	return orderDetails, nil
 
 }
+
+func (r *orderDetailRepositoryImpl) SelectAllJoinDrugByOrderId(ctx context.Context, orderId uint) ([]*entity.OrderDetail, error) {
+	q := `
+		SELECT
+			od.order_detail_id,
+			od.order_id,
+			od.pharmacy_drug_id,
+			od.quantity,
+			od.price,
+			pd.pharmacy_id,
+			pd.drug_id,
+			d.drug_name,
+			d.image_url
+		FROM
+			order_details od
+		JOIN
+			pharmacy_drugs pd ON pd.pharmacy_drug_id = od.pharmacy_drug_id
+		JOIN
+			drugs d ON d.drug_id = pd.drug_id
+		WHERE
+			od.order_id = $1
+		AND
+			od.deleted_at IS NULL
+		ORDER BY
+			od.order_detail_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, orderId)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	orderDetails := make([]*entity.OrderDetail, 0)
+	for rows.Next() {
+		orderDetail := &entity.OrderDetail{}
+		err := rows.Scan(
+			&orderDetail.Id,
+			&orderDetail.OrderId,
+			&orderDetail.PharmacyDrugId,
+			&orderDetail.Quantity,
+			&orderDetail.Price,
+			&orderDetail.PharmacyDrug.PharmacyID,
+			&orderDetail.PharmacyDrug.DrugID,
+			&orderDetail.PharmacyDrug.Drug.Name,
+			&orderDetail.PharmacyDrug.Drug.ImageURL,
+		)
+
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		orderDetail.PharmacyDrug.ID = orderDetail.PharmacyDrugId
+		orderDetail.PharmacyDrug.Drug.ID = orderDetail.PharmacyDrug.DrugID
+		orderDetails = append(orderDetails, orderDetail)
+	}
+
+	if err = rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return orderDetails, nil
+}
+
+func (r *orderDetailRepositoryImpl) UpdateStockReservedByOrderId(ctx context.Context, orderId uint) error {
+	q := `
+		UPDATE
+			order_details
+		SET
+			is_stock_reserved = true
+		WHERE
+			order_id = $1
+		AND
+			deleted_at IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, q, orderId); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *orderDetailRepositoryImpl) UpdateStockReleasedByOrderIds(ctx context.Context, orderIds []uint) ([]*entity.OrderDetail, error) {
+	q := `
+		UPDATE
+			order_details
+		SET
+			is_stock_reserved = false
+		WHERE
+			order_id = ANY($1::int[])
+		AND
+			is_stock_reserved IS true
+		RETURNING
+			order_detail_id, order_id, pharmacy_drug_id, quantity
+	`
+
+	param := new(strings.Builder)
+	param.WriteString("{")
+
+	idsLength := len(orderIds)
+
+	for index, id := range orderIds {
+		param.WriteString(fmt.Sprint(id))
+
+		if index != idsLength-1 {
+			param.WriteString(",")
+		}
+	}
+
+	param.WriteString("}")
+
+	rows, err := r.db.QueryContext(ctx, q, param.String())
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	orderDetails := make([]*entity.OrderDetail, 0)
+	for rows.Next() {
+		orderDetail := &entity.OrderDetail{}
+		err := rows.Scan(
+			&orderDetail.Id,
+			&orderDetail.OrderId,
+			&orderDetail.PharmacyDrugId,
+			&orderDetail.Quantity,
+		)
+
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		orderDetails = append(orderDetails, orderDetail)
+	}
+
+	if err = rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return orderDetails, nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type OrderStatusHistoryRepository interface {
+	InsertMany(ctx context.Context, histories []entity.OrderStatusHistory) error
+	SelectAllByOrderID(ctx context.Context, orderID uint) ([]entity.OrderStatusHistory, error)
+}
+
+type orderStatusHistoryRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewOrderStatusHistoryRepository(db transaction.DBTransaction) *orderStatusHistoryRepositoryImpl {
+	return &orderStatusHistoryRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *orderStatusHistoryRepositoryImpl) InsertMany(ctx context.Context, histories []entity.OrderStatusHistory) error {
+	if len(histories) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0)
+	insertData := make([]string, 0)
+	for _, history := range histories {
+		argsLen := len(args)
+		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3, argsLen+4, argsLen+5, argsLen+6))
+		args = append(args, history.OrderID, history.FromStatus, history.ToStatus, history.ActorRole, history.ActorID, history.Reason)
+	}
+
+	q := `
+		INSERT INTO
+			order_status_histories (order_id, from_status, to_status, actor_role, actor_id, reason)
+		VALUES
+			%s
+	`
+
+	_, err := r.db.ExecContext(ctx, fmt.Sprintf(q, strings.Join(insertData, ",")), args...)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *orderStatusHistoryRepositoryImpl) SelectAllByOrderID(ctx context.Context, orderID uint) ([]entity.OrderStatusHistory, error) {
+	q := `
+		SELECT
+			order_status_history_id, order_id, from_status, to_status, actor_role, actor_id, reason, created_at
+		FROM
+			order_status_histories
+		WHERE
+			order_id = $1
+		AND
+			deleted_at IS NULL
+		ORDER BY
+			created_at, order_status_history_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, orderID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	histories := make([]entity.OrderStatusHistory, 0)
+
+	for rows.Next() {
+		h := entity.OrderStatusHistory{}
+
+		err := rows.Scan(
+			&h.ID,
+			&h.OrderID,
+			&h.FromStatus,
+			&h.ToStatus,
+			&h.ActorRole,
+			&h.ActorID,
+			&h.Reason,
+			&h.CreatedAt,
+		)
+
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		histories = append(histories, h)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return histories, nil
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type PatientRecordAccessLogRepository interface {
+	InsertOne(ctx context.Context, log entity.PatientRecordAccessLog) error
+}
+
+type patientRecordAccessLogRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewPatientRecordAccessLogRepository(db transaction.DBTransaction) *patientRecordAccessLogRepositoryImpl {
+	return &patientRecordAccessLogRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *patientRecordAccessLogRepositoryImpl) InsertOne(ctx context.Context, log entity.PatientRecordAccessLog) error {
+	q := `
+		INSERT INTO
+			patient_record_access_logs (doctor_id, user_id, telemedicine_id, ip_address, user_agent)
+		VALUES
+			($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.ExecContext(ctx, q, log.DoctorID, log.UserID, log.TelemedicineID, log.IPAddress, log.UserAgent)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -32,9 +32,12 @@ type PaymentRepository interface {
 	UpdatePaymentProof(ctx context.Context, payment entity.Payment) (*entity.Payment, error)
 	UserDeletePayment(ctx context.Context, payment entity.Payment) (*entity.Payment, error)
 	AdminDeletePayment(ctx context.Context, payment entity.Payment) (*entity.Payment, error)
+	DoctorCancelPayment(ctx context.Context, payment entity.Payment, doctorId uint) (*entity.Payment, error)
 	GetAllPaymentToConfirm(ctx context.Context, clc *entity.Collection) ([]*entity.Payment, error)
 	GetAllPaymentByUserId(ctx context.Context, userId uint) (map[uint]*entity.Payment, error)
 	UpdatePaymentExpiredAt(ctx context.Context, paymentId uint, futureStatus string) error
+	TryLockExpiry(ctx context.Context) (bool, error)
+	UpdateExpiredUnpaid(ctx context.Context) ([]uint, error)
 }
 
 type paymentRepositoryImpl struct {
@@ -76,6 +79,8 @@ func (r *paymentRepositoryImpl) UpdatePaymentProof(ctx context.Context, payment
 			user_id=$3
 		AND
 			deleted_at is null
+		AND
+			is_expired = false
 		Returning payment_proof, payment_method,full_user_address,total_price,payment_number
 		`
 	err := r.db.QueryRowContext(ctx, q, payment.Proof, payment.Id, payment.UserId).Scan(&payment.Proof, &payment.Method, &payment.FullUserAddress, &payment.TotalPrice, &payment.Number)
@@ -166,6 +171,49 @@ func (r *paymentRepositoryImpl) AdminDeletePayment(ctx context.Context, payment
 	return &payment, err
 }
 
+func (r *paymentRepositoryImpl) DoctorCancelPayment(ctx context.Context, payment entity.Payment, doctorId uint) (*entity.Payment, error) {
+	q := `
+		UPDATE
+			payments p
+		SET
+			deleted_at = now(),
+			refunded_at = CASE WHEN p.payment_proof IS NULL THEN NULL ELSE now() END,
+			updated_at = now()
+		FROM
+			telemedicines t
+		WHERE
+			p.payment_id = $1
+		AND
+			t.payment_id = p.payment_id
+		AND
+			t.doctor_id = $2
+		AND
+			p.deleted_at IS NULL
+		RETURNING
+			p.payment_proof, p.payment_method, p.full_user_address, p.total_price, p.payment_number, p.refunded_at
+	`
+
+	err := r.db.QueryRowContext(ctx, q, payment.Id, doctorId).Scan(
+		&payment.Proof,
+		&payment.Method,
+		&payment.FullUserAddress,
+		&payment.TotalPrice,
+		&payment.Number,
+		&payment.RefundedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
 func (r *paymentRepositoryImpl) GetAllPaymentToConfirm(ctx context.Context, clc *entity.Collection) ([]*entity.Payment, error) {
 	selectColumns := `
 		distinct  
@@ -177,14 +225,15 @@ func (r *paymentRepositoryImpl) GetAllPaymentToConfirm(ctx context.Context, clc
 		p.payment_number,
 		p.total_price,
 		p.updated_at,
-		o.status 
+		coalesce(o.status, t.status)
 	`
 	advanceQuery := `
 			payments p
-		join orders o on p.payment_id = o.payment_id 
+		left join orders o on p.payment_id = o.payment_id 
+		left join telemedicines t on p.payment_id = t.payment_id
 		join users u on p.user_id = u.user_id
 		where 
-			o.status = $1
+			coalesce(o.status, t.status) = $1
 		AND 
 		%s
 		%s
@@ -247,6 +296,7 @@ func (r *paymentRepositoryImpl) GetAllPaymentByUserId(ctx context.Context, userI
 	p.user_id, 
 	p.payment_method,
 	p.payment_expired_at,
+	p.is_expired,
 	p.payment_proof, 
 	p.full_user_address, 
 	p.total_price, 
@@ -308,6 +358,7 @@ func (r *paymentRepositoryImpl) GetAllPaymentByUserId(ctx context.Context, userI
 			&p.UserId,
 			&p.Method,
 			&p.ExpiredAt,
+			&p.IsExpired,
 			&p.Proof,
 			&p.FullUserAddress,
 			&p.TotalPrice,
@@ -366,3 +417,56 @@ func (r *paymentRepositoryImpl) GetAllPaymentByUserId(ctx context.Context, userI
 	return pMap, nil
 
 }
+
+func (r *paymentRepositoryImpl) TryLockExpiry(ctx context.Context) (bool, error) {
+	q := `SELECT pg_try_advisory_xact_lock($1)`
+
+	var locked bool
+	if err := r.db.QueryRowContext(ctx, q, constant.PaymentExpiryLockKey).Scan(&locked); err != nil {
+		logrus.Error(err)
+		return false, err
+	}
+
+	return locked, nil
+}
+
+func (r *paymentRepositoryImpl) UpdateExpiredUnpaid(ctx context.Context) ([]uint, error) {
+	q := `
+		UPDATE
+			payments
+		SET
+			is_expired = true,
+			updated_at = now()
+		WHERE
+			payment_proof IS NULL
+		AND
+			payment_expired_at < now()
+		AND
+			is_expired = false
+		AND
+			deleted_at IS NULL
+		RETURNING
+			payment_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	paymentIDs := make([]uint, 0)
+	for rows.Next() {
+		var id uint
+		if err := rows.Scan(&id); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		paymentIDs = append(paymentIDs, id)
+	}
+
+	return paymentIDs, nil
+}
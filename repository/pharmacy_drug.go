@@ -33,6 +33,8 @@ type PharmacyDrugRepository interface {
 	CreateOne(ctx context.Context, pharmacyDrug entity.PharmacyDrug) (*entity.PharmacyDrug, error)
 	UpdateStockComeOut(ctx context.Context, quantity uint, pharmacyDrugId uint) (*uint, error)
 	UpdateReturnStock(ctx context.Context, orderDetails []*entity.OrderDetail) ([]entity.StockJurnal, error)
+	UpdateReserveStock(ctx context.Context, orderDetails []*entity.OrderDetail) ([]entity.StockJurnal, error)
+	UpdateReleaseReservedStock(ctx context.Context, orderDetails []*entity.OrderDetail) ([]entity.StockJurnal, error)
 	SelectOneNearestByPharmacyManagerDrugId(ctx context.Context, pharmacyDrug entity.PharmacyDrug, quantity uint) (*entity.PharmacyDrug, error)
 	UpdateSubstractionBulkStock(ctx context.Context, stockRequestDrugs map[uint][]*entity.StockRequestDrug) error
 	UpdateOne(ctx context.Context, pharmacyDrug entity.PharmacyDrug, id uint) error
@@ -68,7 +70,7 @@ func (r *pharmacyDrugRepositoryImpl) SelectAllWithinRadius(ctx context.Context,
 		) AS distance,
 		ST_AsEWKT(p.pharmacy_location),
 		pd.pharmacy_drug_id,
-		pd.stock,
+		pd.stock - pd.reserved_stock,
 		pd.price,
 		pd.category_id,
 		pd.is_active,
@@ -331,7 +333,7 @@ func (r *pharmacyDrugRepositoryImpl) SelectOneByID(ctx context.Context, id uint)
 		drug_id,
 		pharmacy_id,
 		category_id,
-		stock,
+		stock - reserved_stock,
 		price,
 		is_active,
 		created_at
@@ -375,7 +377,7 @@ func (r *pharmacyDrugRepositoryImpl) SelectById(ctx context.Context, id uint) (*
 		p.subdistrict_id,
 		ST_AsEWKT(p.pharmacy_location),
 		pd.pharmacy_drug_id,
-		pd.stock,
+		pd.stock - pd.reserved_stock,
 		pd.price,
 		pd.category_id,
 		pd.is_active,
@@ -463,6 +465,8 @@ func (r *pharmacyDrugRepositoryImpl) UpdateOne(ctx context.Context, pharmacyDrug
 			is_active= $6
 		WHERE
 			pharmacy_drug_id = $7
+		AND
+			$4 >= reserved_stock
 		RETURNING
 			drug_id,
 			pharmacy_id,
@@ -490,6 +494,10 @@ func (r *pharmacyDrugRepositoryImpl) UpdateOne(ctx context.Context, pharmacyDrug
 	)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperror.StockBelowReserved
+		}
+
 		logrus.Error(err)
 		return err
 	}
@@ -551,11 +559,16 @@ func (r *pharmacyDrugRepositoryImpl) UpdateStockComeOut(ctx context.Context, qua
 	stock =stock - $1,
 	updated_at=now()
 	where pharmacy_drug_id =$2
+	and stock - reserved_stock >= $1
 	returning stock 
 	`
 
 	err := r.db.QueryRowContext(ctx, q, quantity, pharmacyDrugId).Scan(&stock)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.InsufficientStock
+		}
+		logrus.Error(err)
 		return nil, err
 	}
 	return &stock, nil
@@ -592,8 +605,79 @@ func (r *pharmacyDrugRepositoryImpl) UpdateReturnStock(ctx context.Context, orde
 
 	return stockJournals, nil
 }
+func (r *pharmacyDrugRepositoryImpl) UpdateReserveStock(ctx context.Context, orderDetails []*entity.OrderDetail) ([]entity.StockJurnal, error) {
+	q := `
+	update pharmacy_drugs
+	set reserved_stock =reserved_stock + $1,
+	updated_at=now()
+	where pharmacy_drug_id =$2
+	and stock - reserved_stock >= $1
+	and is_active is true
+	and deleted_at is null
+	returning drug_id, pharmacy_id
+	`
+	stmt, err := r.db.PrepareContext(ctx, q)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+	var stockJournals []entity.StockJurnal
+
+	for _, orderDetail := range orderDetails {
+		var stockJurnal entity.StockJurnal
+		err := stmt.QueryRowContext(ctx, orderDetail.Quantity, orderDetail.PharmacyDrugId).Scan(&stockJurnal.DrugId, &stockJurnal.PharmacyId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, apperror.InsufficientStock
+			}
+			logrus.Error(err)
+			return nil, err
+		}
+		stockJurnal.Quantity = int(orderDetail.Quantity) * -1
+		stockJurnal.Description = constant.ReservedStock
+		stockJournals = append(stockJournals, stockJurnal)
+	}
+
+	return stockJournals, nil
+}
+func (r *pharmacyDrugRepositoryImpl) UpdateReleaseReservedStock(ctx context.Context, orderDetails []*entity.OrderDetail) ([]entity.StockJurnal, error) {
+	q := `
+	update pharmacy_drugs
+	set reserved_stock =reserved_stock - $1,
+	updated_at=now()
+	where pharmacy_drug_id =$2
+	and reserved_stock >= $1
+	returning drug_id, pharmacy_id
+	`
+	stmt, err := r.db.PrepareContext(ctx, q)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+	defer stmt.Close()
+	var stockJournals []entity.StockJurnal
+
+	for _, orderDetail := range orderDetails {
+		var stockJurnal entity.StockJurnal
+		err := stmt.QueryRowContext(ctx, orderDetail.Quantity, orderDetail.PharmacyDrugId).Scan(&stockJurnal.DrugId, &stockJurnal.PharmacyId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				logrus.Error(err)
+				return nil, apperror.ReleaseExceedsReserved
+			}
+			logrus.Error(err)
+			return nil, err
+		}
+		stockJurnal.Quantity = int(orderDetail.Quantity)
+		stockJurnal.Description = constant.ReleasedStock
+		stockJournals = append(stockJournals, stockJurnal)
+	}
+
+	return stockJournals, nil
+}
 func (r *pharmacyDrugRepositoryImpl) UpdateSubstractionBulkStock(ctx context.Context, stockRequestDrugs map[uint][]*entity.StockRequestDrug) error {
-	stmt, err := r.db.PrepareContext(ctx, "UPDATE pharmacy_drugs SET stock = stock - $1, updated_at = NOW() WHERE drug_id = $2 AND pharmacy_id = $3 AND stock>= $1 ")
+	stmt, err := r.db.PrepareContext(ctx, "UPDATE pharmacy_drugs SET stock = stock - $1, updated_at = NOW() WHERE drug_id = $2 AND pharmacy_id = $3 AND stock - reserved_stock >= $1 ")
 	if err != nil {
 		logrus.Error(err)
 		return err
@@ -665,7 +749,7 @@ func (r *pharmacyDrugRepositoryImpl) SelectOneNearestByPharmacyManagerDrugId(ctx
 			and 
 			d.drug_id=$4
 			and 
-			pd.stock >=$5
+			pd.stock - pd.reserved_stock >=$5
 			and
 			pd.is_active is true
 			and
@@ -696,7 +780,7 @@ func (r *pharmacyDrugRepositoryImpl) SelectNearestPharmaciesByDrugID(ctx context
 		pd.drug_id,
 		pd.pharmacy_id,
 		pd.category_id,
-		pd.stock,
+		pd.stock - pd.reserved_stock,
 		pd.price,
 		pd.is_active,
 		pd.created_at,
@@ -793,7 +877,7 @@ func (r *pharmacyDrugRepositoryImpl) SelectMostBought(ctx context.Context, limit
 	selectColumns := `
 		od.pharmacy_drug_id,
 		pd.pharmacy_id,
-		pd.stock,
+		pd.stock - pd.reserved_stock,
 		pd.price,
 		pd.is_active,
 		p.pharmacy_id,
@@ -908,7 +992,7 @@ func (r *pharmacyDrugRepositoryImpl) SelectAllWithLimit(ctx context.Context, lim
 			pd.pharmacy_drug_id,
 			pd.pharmacy_id,
 			pd.category_id,
-			pd.stock,
+			pd.stock - pd.reserved_stock,
 			pd.price,
 			pd.is_active,
 			p.pharmacy_id,
@@ -939,7 +1023,7 @@ func (r *pharmacyDrugRepositoryImpl) SelectAllWithLimit(ctx context.Context, lim
 		LEFT JOIN pharmacies p ON p.pharmacy_id  = pd.pharmacy_id
 		LEFT JOIN drugs d ON d.drug_id = pd.drug_id
 		WHERE pd.is_active = true AND d.is_active = true
-		ORDER BY pd.stock DESC
+		ORDER BY pd.stock - pd.reserved_stock DESC
 		LIMIT %d
 	`, limit)
 
@@ -19,6 +19,7 @@ type PharmacyManagerRepository interface {
 	SelectOneByEmail(ctx context.Context, email string) (*entity.PharmacyManager, error)
 	InsertOne(ctx context.Context, pm entity.PharmacyManager) (*entity.PharmacyManager, error)
 	UpdateByID(ctx context.Context, pm entity.PharmacyManager) error
+	UpdatePassword(ctx context.Context, id uint, password string) error
 }
 
 type pharmacyManagerRepositoryImpl struct {
@@ -176,3 +177,26 @@ func (r *pharmacyManagerRepositoryImpl) UpdateByID(ctx context.Context, pm entit
 
 	return nil
 }
+
+func (r *pharmacyManagerRepositoryImpl) UpdatePassword(ctx context.Context, id uint, password string) error {
+	q := `
+		UPDATE pharmacy_managers
+		SET
+			pharmacy_manager_password = $1,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			pharmacy_manager_id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, q, password, id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
@@ -2,9 +2,12 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
 	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 
@@ -14,6 +17,8 @@ import (
 type PrescriptionRepository interface {
 	GetAllByTelemedicineID(ctx context.Context, telemedicineID uint) ([]entity.Prescription, error)
 	InsertMany(ctx context.Context, pss []entity.Prescription) ([]entity.Prescription, error)
+	SelectOneForUpdateByID(ctx context.Context, id uint, userID uint) (*entity.Prescription, error)
+	SelectAllActiveDrugIDsByPatient(ctx context.Context, userID uint, dependentID *uint) ([]uint, error)
 }
 
 type prescriptionRepositoryImpl struct {
@@ -33,7 +38,13 @@ func (r *prescriptionRepositoryImpl) GetAllByTelemedicineID(ctx context.Context,
 			p.telemedicine_id,
 			p.drug_id,
 			p.quantity,
+			COALESCE((SELECT redeemed_quantity FROM prescription_fill_summaries WHERE prescription_id = p.prescription_id), 0),
 			p.notes,
+			p.frequency,
+			p.duration_days,
+			p.route,
+			p.issued_at,
+			p.expired_at,
 			p.created_at,
 			d.drug_id,
 			d.manufacturer_id,
@@ -75,7 +86,13 @@ func (r *prescriptionRepositoryImpl) GetAllByTelemedicineID(ctx context.Context,
 			&scan.TelemedicineID,
 			&scan.DrugID,
 			&scan.Quantity,
+			&scan.RedeemedQuantity,
 			&scan.Notes,
+			&scan.Frequency,
+			&scan.DurationDays,
+			&scan.Route,
+			&scan.IssuedAt,
+			&scan.ExpiredAt,
 			&scan.CreatedAt,
 			&scan.Drug.ID,
 			&scan.Drug.ManufacturerID,
@@ -110,17 +127,17 @@ func (r *prescriptionRepositoryImpl) InsertMany(ctx context.Context, pss []entit
 	argsLen := len(args)
 	q := `
 		INSERT INTO
-			prescriptions (telemedicine_id, drug_id, quantity, notes)
+			prescriptions (telemedicine_id, drug_id, quantity, notes, frequency, duration_days, route, expired_at)
 		VALUES
 			%s
 		RETURNING
-			prescription_id, telemedicine_id, drug_id, quantity, notes, created_at
+			prescription_id, telemedicine_id, drug_id, quantity, notes, frequency, duration_days, route, issued_at, expired_at, created_at
 	`
 
 	insertData := make([]string, 0)
 	for _, ps := range pss {
-		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3, argsLen+4))
-		args = append(args, ps.TelemedicineID, ps.DrugID, ps.Quantity, ps.Notes)
+		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3, argsLen+4, argsLen+5, argsLen+6, argsLen+7, argsLen+8))
+		args = append(args, ps.TelemedicineID, ps.DrugID, ps.Quantity, ps.Notes, ps.Frequency, ps.DurationDays, ps.Route, ps.ExpiredAt)
 		argsLen = len(args)
 	}
 
@@ -135,7 +152,7 @@ func (r *prescriptionRepositoryImpl) InsertMany(ctx context.Context, pss []entit
 	results := make([]entity.Prescription, 0)
 	for rows.Next() {
 		var scan entity.Prescription
-		if err := rows.Scan(&scan.ID, &scan.TelemedicineID, &scan.DrugID, &scan.Quantity, &scan.Notes, &scan.CreatedAt); err != nil {
+		if err := rows.Scan(&scan.ID, &scan.TelemedicineID, &scan.DrugID, &scan.Quantity, &scan.Notes, &scan.Frequency, &scan.DurationDays, &scan.Route, &scan.IssuedAt, &scan.ExpiredAt, &scan.CreatedAt); err != nil {
 			logrus.Error(err)
 			return nil, err
 		}
@@ -145,3 +162,99 @@ func (r *prescriptionRepositoryImpl) InsertMany(ctx context.Context, pss []entit
 
 	return results, nil
 }
+
+func (r *prescriptionRepositoryImpl) SelectOneForUpdateByID(ctx context.Context, id uint, userID uint) (*entity.Prescription, error) {
+	q := `
+		SELECT
+			p.prescription_id,
+			p.telemedicine_id,
+			p.drug_id,
+			p.quantity,
+			COALESCE((SELECT redeemed_quantity FROM prescription_fill_summaries WHERE prescription_id = p.prescription_id), 0),
+			p.notes,
+			p.frequency,
+			p.duration_days,
+			p.route,
+			p.issued_at,
+			p.expired_at,
+			p.created_at
+		FROM
+			prescriptions p
+		JOIN
+			telemedicines t ON t.telemedicine_id = p.telemedicine_id
+		WHERE
+			p.prescription_id = $1
+		AND
+			t.user_id = $2
+		AND
+			p.deleted_at IS NULL
+		FOR UPDATE OF p
+	`
+
+	var scan entity.Prescription
+	err := r.db.QueryRowContext(ctx, q, id, userID).Scan(
+		&scan.ID,
+		&scan.TelemedicineID,
+		&scan.DrugID,
+		&scan.Quantity,
+		&scan.RedeemedQuantity,
+		&scan.Notes,
+		&scan.Frequency,
+		&scan.DurationDays,
+		&scan.Route,
+		&scan.IssuedAt,
+		&scan.ExpiredAt,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *prescriptionRepositoryImpl) SelectAllActiveDrugIDsByPatient(ctx context.Context, userID uint, dependentID *uint) ([]uint, error) {
+	q := `
+		SELECT DISTINCT
+			p.drug_id
+		FROM
+			prescriptions p
+		JOIN
+			telemedicines t ON t.telemedicine_id = p.telemedicine_id
+		WHERE
+			t.user_id = $1
+		AND
+			t.dependent_id IS NOT DISTINCT FROM $2::bigint
+		AND
+			p.expired_at > NOW()
+		AND
+			p.deleted_at IS NULL
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, userID, dependentID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := make([]uint, 0)
+	for rows.Next() {
+		var drugID uint
+		if err := rows.Scan(&drugID); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		results = append(results, drugID)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type PrescriptionFillRepository interface {
+	SelectAllByTelemedicineID(ctx context.Context, telemedicineID uint) ([]entity.PrescriptionFill, error)
+	SelectAllByOrderID(ctx context.Context, orderID uint) ([]entity.PrescriptionFill, error)
+	InsertMany(ctx context.Context, fills []entity.PrescriptionFill) error
+}
+
+type prescriptionFillRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewPrescriptionFillRepository(db transaction.DBTransaction) *prescriptionFillRepositoryImpl {
+	return &prescriptionFillRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *prescriptionFillRepositoryImpl) SelectAllByTelemedicineID(ctx context.Context, telemedicineID uint) ([]entity.PrescriptionFill, error) {
+	q := `
+		SELECT
+			pf.prescription_fill_id,
+			pf.prescription_id,
+			pf.order_detail_id,
+			od.order_id,
+			o.status,
+			pf.quantity,
+			pf.created_at
+		FROM
+			prescription_fills pf
+		JOIN
+			prescriptions p ON p.prescription_id = pf.prescription_id
+		JOIN
+			order_details od ON od.order_detail_id = pf.order_detail_id
+		JOIN
+			orders o ON o.order_id = od.order_id
+		WHERE
+			p.telemedicine_id = $1
+		AND
+			pf.deleted_at IS NULL
+		ORDER BY
+			pf.created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, telemedicineID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	fills := make([]entity.PrescriptionFill, 0)
+	for rows.Next() {
+		var scan entity.PrescriptionFill
+		if err := rows.Scan(
+			&scan.ID,
+			&scan.PrescriptionID,
+			&scan.OrderDetailID,
+			&scan.OrderID,
+			&scan.OrderStatus,
+			&scan.Quantity,
+			&scan.CreatedAt,
+		); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		fills = append(fills, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return fills, nil
+}
+
+func (r *prescriptionFillRepositoryImpl) SelectAllByOrderID(ctx context.Context, orderID uint) ([]entity.PrescriptionFill, error) {
+	q := `
+		SELECT
+			pf.prescription_fill_id,
+			pf.prescription_id,
+			pf.order_detail_id,
+			od.order_id,
+			o.status,
+			pf.quantity,
+			pf.created_at,
+			p.telemedicine_id,
+			p.drug_id,
+			p.quantity,
+			COALESCE((SELECT redeemed_quantity FROM prescription_fill_summaries WHERE prescription_id = p.prescription_id), 0),
+			p.notes,
+			p.frequency,
+			p.duration_days,
+			p.route,
+			p.issued_at,
+			p.expired_at
+		FROM
+			prescription_fills pf
+		JOIN
+			prescriptions p ON p.prescription_id = pf.prescription_id
+		JOIN
+			order_details od ON od.order_detail_id = pf.order_detail_id
+		JOIN
+			orders o ON o.order_id = od.order_id
+		WHERE
+			od.order_id = $1
+		AND
+			pf.deleted_at IS NULL
+		ORDER BY
+			pf.prescription_fill_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, orderID)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	fills := make([]entity.PrescriptionFill, 0)
+	for rows.Next() {
+		var scan entity.PrescriptionFill
+		if err := rows.Scan(
+			&scan.ID,
+			&scan.PrescriptionID,
+			&scan.OrderDetailID,
+			&scan.OrderID,
+			&scan.OrderStatus,
+			&scan.Quantity,
+			&scan.CreatedAt,
+			&scan.Prescription.TelemedicineID,
+			&scan.Prescription.DrugID,
+			&scan.Prescription.Quantity,
+			&scan.Prescription.RedeemedQuantity,
+			&scan.Prescription.Notes,
+			&scan.Prescription.Frequency,
+			&scan.Prescription.DurationDays,
+			&scan.Prescription.Route,
+			&scan.Prescription.IssuedAt,
+			&scan.Prescription.ExpiredAt,
+		); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		scan.Prescription.ID = scan.PrescriptionID
+		fills = append(fills, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return fills, nil
+}
+
+func (r *prescriptionFillRepositoryImpl) InsertMany(ctx context.Context, fills []entity.PrescriptionFill) error {
+	args := make([]any, 0)
+	q := `
+		INSERT INTO
+			prescription_fills (prescription_id, order_detail_id, quantity)
+		VALUES
+			%s
+	`
+
+	insertData := make([]string, 0)
+	for _, fill := range fills {
+		argsLen := len(args)
+		insertData = append(insertData, fmt.Sprintf("($%d, $%d, $%d)", argsLen+1, argsLen+2, argsLen+3))
+		args = append(args, fill.PrescriptionID, fill.OrderDetailID, fill.Quantity)
+	}
+
+	if _, err := r.db.ExecContext(ctx, fmt.Sprintf(q, strings.Join(insertData, ",")), args...); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type RefreshTokenRepository interface {
+	SelectOneByToken(ctx context.Context, actorType string, token string) (*entity.RefreshToken, error)
+	InsertOne(ctx context.Context, newData entity.RefreshToken) (*entity.RefreshToken, error)
+	UpdateUsedByID(ctx context.Context, id uint) error
+}
+
+type refreshTokenRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewRefreshTokenRepository(db transaction.DBTransaction) *refreshTokenRepositoryImpl {
+	return &refreshTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *refreshTokenRepositoryImpl) SelectOneByToken(ctx context.Context, actorType string, token string) (*entity.RefreshToken, error) {
+	q := `
+		SELECT
+			refresh_token_id, actor_type, actor_id, actor_email, token, family, expired_at, used_at, revoked_at, created_at
+		FROM
+			refresh_tokens
+		WHERE
+			token = $1
+		AND
+			actor_type = $2
+	`
+
+	var scan entity.RefreshToken
+	err := r.db.QueryRowContext(ctx, q, token, actorType).Scan(
+		&scan.ID,
+		&scan.ActorType,
+		&scan.ActorID,
+		&scan.ActorEmail,
+		&scan.Token,
+		&scan.Family,
+		&scan.ExpiredAt,
+		&scan.UsedAt,
+		&scan.RevokedAt,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *refreshTokenRepositoryImpl) InsertOne(ctx context.Context, newData entity.RefreshToken) (*entity.RefreshToken, error) {
+	q := `
+		INSERT INTO refresh_tokens
+			(actor_type, actor_id, actor_email, token, family, expired_at)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		RETURNING
+			refresh_token_id, actor_type, actor_id, actor_email, token, family, expired_at, used_at, revoked_at, created_at
+	`
+
+	var scan entity.RefreshToken
+	err := r.db.QueryRowContext(ctx, q,
+		newData.ActorType,
+		newData.ActorID,
+		newData.ActorEmail,
+		newData.Token,
+		newData.Family,
+		newData.ExpiredAt,
+	).Scan(
+		&scan.ID,
+		&scan.ActorType,
+		&scan.ActorID,
+		&scan.ActorEmail,
+		&scan.Token,
+		&scan.Family,
+		&scan.ExpiredAt,
+		&scan.UsedAt,
+		&scan.RevokedAt,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *refreshTokenRepositoryImpl) UpdateUsedByID(ctx context.Context, id uint) error {
+	q := `
+		UPDATE
+			refresh_tokens
+		SET
+			used_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			refresh_token_id = $1
+		AND
+			used_at IS NULL
+		AND
+			revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, q, id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
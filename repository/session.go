@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+
+	"github.com/sirupsen/logrus"
+)
+
+type SessionRepository interface {
+	SelectOneActiveByID(ctx context.Context, id string, actorType string, actorID uint) (*entity.Session, error)
+	InsertOne(ctx context.Context, session entity.Session) (*entity.Session, error)
+	RevokeByID(ctx context.Context, id string) error
+	RevokeByActor(ctx context.Context, actorType string, actorID uint, exceptID string) error
+}
+
+type sessionRepositoryImpl struct {
+	db transaction.DBTransaction
+}
+
+func NewSessionRepository(db transaction.DBTransaction) *sessionRepositoryImpl {
+	return &sessionRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *sessionRepositoryImpl) SelectOneActiveByID(ctx context.Context, id string, actorType string, actorID uint) (*entity.Session, error) {
+	q := `
+		SELECT
+			session_id, actor_type, actor_id, revoked_at, created_at
+		FROM
+			sessions
+		WHERE
+			session_id = $1
+		AND
+			actor_type = $2
+		AND
+			actor_id = $3
+		AND
+			revoked_at IS NULL
+	`
+
+	var scan entity.Session
+	err := r.db.QueryRowContext(ctx, q, id, actorType, actorID).Scan(
+		&scan.ID,
+		&scan.ActorType,
+		&scan.ActorID,
+		&scan.RevokedAt,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrResourceNotFound
+		}
+
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *sessionRepositoryImpl) InsertOne(ctx context.Context, session entity.Session) (*entity.Session, error) {
+	q := `
+		INSERT INTO sessions
+			(session_id, actor_type, actor_id)
+		VALUES
+			($1, $2, $3)
+		RETURNING
+			session_id, actor_type, actor_id, revoked_at, created_at
+	`
+
+	var scan entity.Session
+	err := r.db.QueryRowContext(ctx, q,
+		session.ID,
+		session.ActorType,
+		session.ActorID,
+	).Scan(
+		&scan.ID,
+		&scan.ActorType,
+		&scan.ActorID,
+		&scan.RevokedAt,
+		&scan.CreatedAt,
+	)
+
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return &scan, nil
+}
+
+func (r *sessionRepositoryImpl) RevokeByID(ctx context.Context, id string) error {
+	q := `
+		WITH revoked AS (
+			UPDATE
+				sessions
+			SET
+				revoked_at = CURRENT_TIMESTAMP,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE
+				session_id = $1
+			AND
+				revoked_at IS NULL
+			RETURNING
+				session_id
+		)
+		UPDATE
+			refresh_tokens
+		SET
+			revoked_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			family IN (SELECT session_id FROM revoked)
+		AND
+			revoked_at IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, q, id); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *sessionRepositoryImpl) RevokeByActor(ctx context.Context, actorType string, actorID uint, exceptID string) error {
+	q := `
+		WITH revoked AS (
+			UPDATE
+				sessions
+			SET
+				revoked_at = CURRENT_TIMESTAMP,
+				updated_at = CURRENT_TIMESTAMP
+			WHERE
+				actor_type = $1
+			AND
+				actor_id = $2
+			AND
+				session_id <> $3
+			AND
+				revoked_at IS NULL
+			RETURNING
+				session_id
+		)
+		UPDATE
+			refresh_tokens
+		SET
+			revoked_at = CURRENT_TIMESTAMP,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE
+			family IN (SELECT session_id FROM revoked)
+		AND
+			revoked_at IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, q, actorType, actorID, exceptID); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
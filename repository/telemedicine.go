@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 	"Alice-Seahat-Healthcare/seahat-be/utils"
@@ -26,6 +28,16 @@ type TelemedicineRepository interface {
 	GetAllTelemedicine(ctx context.Context, userID *uint, doctorID *uint, clc *entity.Collection) ([]entity.Telemedicine, error)
 	UpdateOne(ctx context.Context, updateTelemedicine entity.Telemedicine) error
 	SelectOneByIdJoinDoctorUser(ctx context.Context, telemedicine entity.Telemedicine) (*entity.Telemedicine, error)
+	UpdateStatusByPaymentID(ctx context.Context, paymentID uint, futureStatus string, recentStatus string) (int64, error)
+	UpdateCancelledByExpiredPayment(ctx context.Context) error
+	UpdateStartAt(ctx context.Context, id uint, startAt time.Time) error
+	UpdateCancelledByID(ctx context.Context, id uint) error
+	LockQueueByDoctorID(ctx context.Context, doctorID uint) error
+	UpdateAdmittedByDoctorID(ctx context.Context, doctorID uint, maxConcurrent int) ([]uint, error)
+	CountQueuedBeforeID(ctx context.Context, doctorID uint, id uint) (int, error)
+	SelectAverageDurationByDoctorID(ctx context.Context, doctorID uint) (*time.Duration, error)
+	UpdateEndedByIdle(ctx context.Context, idleTimeout time.Duration) ([]entity.Telemedicine, error)
+	SelectAllHistoryByPatient(ctx context.Context, userID uint, dependentID *uint, clc *entity.Collection) ([]entity.Telemedicine, error)
 }
 
 type telemedicineRepositoryImpl struct {
@@ -41,7 +53,7 @@ func NewTelemedicineRepository(db transaction.DBTransaction) *telemedicineReposi
 func (r *telemedicineRepositoryImpl) SelectOneByID(ctx context.Context, id uint, userID *uint, doctorID *uint) (*entity.Telemedicine, error) {
 	q := `
 		SELECT 
-			telemedicine_id, user_id , doctor_id , end_at,diagnose , price, start_rest_at , rest_duration , medical_certificate_url, created_at,prescription_certificate_url	 
+			telemedicine_id, user_id, dependent_id, doctor_id , end_at, session_status, price, start_rest_at , rest_duration , medical_certificate_url, created_at,prescription_certificate_url, payment_id, status, start_at, admitted_at
 		FROM 
 			telemedicines
 		WHERE
@@ -63,15 +75,20 @@ func (r *telemedicineRepositoryImpl) SelectOneByID(ctx context.Context, id uint,
 	err := r.db.QueryRowContext(ctx, q, id).Scan(
 		&scan.ID,
 		&scan.User.ID,
+		&scan.DependentID,
 		&scan.Doctor.ID,
 		&scan.EndAt,
-		&scan.Diagnose,
+		&scan.SessionStatus,
 		&scan.Price,
 		&scan.StartRestAt,
 		&scan.RestDuration,
 		&scan.MedicalCertificateURL,
 		&scan.CreatedAt,
 		&scan.PrescriptionUrl,
+		&scan.PaymentID,
+		&scan.Status,
+		&scan.StartAt,
+		&scan.AdmittedAt,
 	)
 
 	if err != nil {
@@ -91,15 +108,20 @@ func (r *telemedicineRepositoryImpl) SelectOneOngoingByUserAndDoctorID(ctx conte
 		SELECT 
 			telemedicine_id, 
 			user_id , 
+			dependent_id,
 			doctor_id, 
 			end_at,
-			diagnose, 
+			session_status,
 			price, 
 			start_rest_at, 
 			rest_duration, 
 			medical_certificate_url, 
 			created_at,
-			prescription_certificate_url	 
+			prescription_certificate_url,
+			payment_id,
+			status,
+			start_at,
+			admitted_at
 		FROM 
 			telemedicines
 		WHERE
@@ -116,15 +138,20 @@ func (r *telemedicineRepositoryImpl) SelectOneOngoingByUserAndDoctorID(ctx conte
 	err := r.db.QueryRowContext(ctx, q, userID, doctorID).Scan(
 		&scan.ID,
 		&scan.User.ID,
+		&scan.DependentID,
 		&scan.Doctor.ID,
 		&scan.EndAt,
-		&scan.Diagnose,
+		&scan.SessionStatus,
 		&scan.Price,
 		&scan.StartRestAt,
 		&scan.RestDuration,
 		&scan.MedicalCertificateURL,
 		&scan.CreatedAt,
 		&scan.PrescriptionUrl,
+		&scan.PaymentID,
+		&scan.Status,
+		&scan.StartAt,
+		&scan.AdmittedAt,
 	)
 
 	if err != nil {
@@ -143,15 +170,20 @@ func (r *telemedicineRepositoryImpl) GetAllTelemedicine(ctx context.Context, use
 	selectColumns := `
 		telemedicine_id,
 		user_id,
+		dependent_id,
 		doctor_id,
 		end_at,
-		diagnose,
+		session_status,
 		price,
 		start_rest_at,
 		rest_duration,
 		medical_certificate_url,
 		created_at,
-		prescription_certificate_url
+		prescription_certificate_url,
+		payment_id,
+		status,
+		start_at,
+		admitted_at
 	`
 	advanceQuery := `
 			telemedicines
@@ -196,15 +228,20 @@ func (r *telemedicineRepositoryImpl) GetAllTelemedicine(ctx context.Context, use
 		err := rows.Scan(
 			&telemedicine.ID,
 			&telemedicine.User.ID,
+			&telemedicine.DependentID,
 			&telemedicine.Doctor.ID,
 			&telemedicine.EndAt,
-			&telemedicine.Diagnose,
+			&telemedicine.SessionStatus,
 			&telemedicine.Price,
 			&telemedicine.StartRestAt,
 			&telemedicine.RestDuration,
 			&telemedicine.MedicalCertificateURL,
 			&telemedicine.CreatedAt,
 			&telemedicine.PrescriptionUrl,
+			&telemedicine.PaymentID,
+			&telemedicine.Status,
+			&telemedicine.StartAt,
+			&telemedicine.AdmittedAt,
 		)
 
 		if err != nil {
@@ -221,6 +258,7 @@ func (r *telemedicineRepositoryImpl) SelectOneByIdJoinDoctorUser(ctx context.Con
 			u.user_name,
 			u.date_of_birth,
 			u.gender,
+			t.dependent_id,
 			t.doctor_id,
 			d.doctor_name,
 			s.specialization_name ,
@@ -249,6 +287,7 @@ func (r *telemedicineRepositoryImpl) SelectOneByIdJoinDoctorUser(ctx context.Con
 		&telemedicine.User.Name,
 		&telemedicine.User.DateOfBirth,
 		&telemedicine.User.Gender,
+		&telemedicine.DependentID,
 		&telemedicine.Doctor.ID,
 		&telemedicine.Doctor.Name,
 		&telemedicine.Doctor.Specialization.Name,
@@ -270,29 +309,38 @@ func (r *telemedicineRepositoryImpl) SelectOneByIdJoinDoctorUser(ctx context.Con
 func (r *telemedicineRepositoryImpl) InsertOne(ctx context.Context, newTelemedicine entity.Telemedicine) (*entity.Telemedicine, error) {
 	q := `
 		INSERT INTO telemedicines 
-			(user_id, doctor_id, price)
+			(user_id, doctor_id, price, payment_id, status, start_at, admitted_at, dependent_id)
 		VALUES
-			($1, $2, $3)
+			($1, $2, $3, $4, $5, $6, $6, $7)
 		RETURNING
-			telemedicine_id, user_id, doctor_id, end_at, diagnose, price, start_rest_at, rest_duration, medical_certificate_url, created_at,prescription_certificate_url
+			telemedicine_id, user_id, dependent_id, doctor_id, end_at, session_status, price, start_rest_at, rest_duration, medical_certificate_url, created_at,prescription_certificate_url, payment_id, status, start_at, admitted_at
 	`
 	var scan entity.Telemedicine
 	err := r.db.QueryRowContext(ctx, q,
 		newTelemedicine.User.ID,
 		newTelemedicine.Doctor.ID,
 		newTelemedicine.Price,
+		newTelemedicine.PaymentID,
+		newTelemedicine.Status,
+		newTelemedicine.StartAt,
+		newTelemedicine.DependentID,
 	).Scan(
 		&scan.ID,
 		&scan.User.ID,
+		&scan.DependentID,
 		&scan.Doctor.ID,
 		&scan.EndAt,
-		&scan.Diagnose,
+		&scan.SessionStatus,
 		&scan.Price,
 		&scan.StartRestAt,
 		&scan.RestDuration,
 		&scan.MedicalCertificateURL,
 		&scan.CreatedAt,
 		&scan.PrescriptionUrl,
+		&scan.PaymentID,
+		&scan.Status,
+		&scan.StartAt,
+		&scan.AdmittedAt,
 	)
 
 	if err != nil {
@@ -310,7 +358,7 @@ func (r *telemedicineRepositoryImpl) UpdateOne(ctx context.Context, updateTeleme
 			telemedicines t
 		SET 
 			end_at = $1,
-			diagnose = $2,
+			session_status = $2,
 			start_rest_at = $3,
 			rest_duration = $4,
 			medical_certificate_url = $5,
@@ -325,7 +373,7 @@ func (r *telemedicineRepositoryImpl) UpdateOne(ctx context.Context, updateTeleme
 		and 
 			t.user_id =u.user_id  
 	`
-	result, err := r.db.ExecContext(ctx, q, updateTelemedicine.EndAt, updateTelemedicine.Diagnose, updateTelemedicine.StartRestAt, updateTelemedicine.RestDuration, updateTelemedicine.MedicalCertificateURL, updateTelemedicine.PrescriptionUrl, updateTelemedicine.ID, updateTelemedicine.Doctor.ID)
+	result, err := r.db.ExecContext(ctx, q, updateTelemedicine.EndAt, updateTelemedicine.SessionStatus, updateTelemedicine.StartRestAt, updateTelemedicine.RestDuration, updateTelemedicine.MedicalCertificateURL, updateTelemedicine.PrescriptionUrl, updateTelemedicine.ID, updateTelemedicine.Doctor.ID)
 	if err != nil {
 		logrus.Error(err)
 		return err
@@ -338,3 +386,449 @@ func (r *telemedicineRepositoryImpl) UpdateOne(ctx context.Context, updateTeleme
 	return nil
 
 }
+
+func (r *telemedicineRepositoryImpl) UpdateStatusByPaymentID(ctx context.Context, paymentID uint, futureStatus string, recentStatus string) (int64, error) {
+	q := `
+		WITH updated AS (
+			UPDATE
+				telemedicines
+			SET
+				status = $1,
+				end_at = CASE WHEN $1 = $2 THEN now() ELSE end_at END,
+				session_status = CASE WHEN $1 = $2 THEN $6 ELSE session_status END,
+				updated_at = now()
+			WHERE
+				payment_id = $3
+			AND
+				status = $4
+			AND
+				deleted_at IS NULL
+			RETURNING
+				telemedicine_id, status
+		), cancelled AS (
+			UPDATE
+				appointments
+			SET
+				status = $2,
+				updated_at = now()
+			WHERE
+				telemedicine_id IN (SELECT telemedicine_id FROM updated WHERE status = $2)
+			AND
+				status = $5
+		)
+		SELECT COUNT(*) FROM updated
+	`
+
+	var rowsAffected int64
+	err := r.db.QueryRowContext(ctx, q, futureStatus, constant.Cancelled, paymentID, recentStatus, constant.AppointmentBooked, constant.SessionEnded).Scan(&rowsAffected)
+	if err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	return rowsAffected, nil
+}
+
+func (r *telemedicineRepositoryImpl) UpdateCancelledByExpiredPayment(ctx context.Context) error {
+	q := `
+		WITH cancelled AS (
+			UPDATE
+				telemedicines t
+			SET
+				status = $1,
+				end_at = now(),
+				session_status = $4,
+				updated_at = now()
+			FROM
+				payments p
+			WHERE
+				t.payment_id = p.payment_id
+			AND
+				t.status = $2
+			AND
+				p.payment_proof IS NULL
+			AND
+				p.payment_expired_at < now()
+			AND
+				t.deleted_at IS NULL
+			RETURNING
+				t.telemedicine_id
+		)
+		UPDATE
+			appointments
+		SET
+			status = $1,
+			updated_at = now()
+		WHERE
+			telemedicine_id IN (SELECT telemedicine_id FROM cancelled)
+		AND
+			status = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, q, constant.Cancelled, constant.WaitingForPayment, constant.AppointmentBooked, constant.SessionEnded); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *telemedicineRepositoryImpl) UpdateStartAt(ctx context.Context, id uint, startAt time.Time) error {
+	q := `
+		UPDATE
+			telemedicines
+		SET
+			start_at = $1,
+			admitted_at = $1,
+			updated_at = now()
+		WHERE
+			telemedicine_id = $2
+		AND
+			end_at IS NULL
+		AND
+			deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, q, startAt, id)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (r *telemedicineRepositoryImpl) UpdateCancelledByID(ctx context.Context, id uint) error {
+	q := `
+		UPDATE
+			telemedicines
+		SET
+			status = $1,
+			end_at = now(),
+			session_status = $3,
+			updated_at = now()
+		WHERE
+			telemedicine_id = $2
+		AND
+			end_at IS NULL
+		AND
+			deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, q, constant.Cancelled, id, constant.SessionEnded)
+	if err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+		return apperror.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (r *telemedicineRepositoryImpl) LockQueueByDoctorID(ctx context.Context, doctorID uint) error {
+	q := `SELECT pg_advisory_xact_lock($1, $2)`
+
+	if _, err := r.db.ExecContext(ctx, q, constant.TelemedicineQueueLockKey, doctorID); err != nil {
+		logrus.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (r *telemedicineRepositoryImpl) UpdateAdmittedByDoctorID(ctx context.Context, doctorID uint, maxConcurrent int) ([]uint, error) {
+	q := `
+		WITH active AS (
+			SELECT
+				COUNT(*) AS total
+			FROM
+				telemedicines
+			WHERE
+				doctor_id = $1
+			AND
+				admitted_at <= now()
+			AND
+				end_at IS NULL
+			AND
+				deleted_at IS NULL
+		), queued AS (
+			SELECT
+				telemedicine_id
+			FROM
+				telemedicines
+			WHERE
+				doctor_id = $1
+			AND
+				admitted_at IS NULL
+			AND
+				end_at IS NULL
+			AND
+				status = $3
+			AND
+				deleted_at IS NULL
+			ORDER BY
+				telemedicine_id
+			LIMIT
+				GREATEST($2 - (SELECT total FROM active), 0)
+		)
+		UPDATE
+			telemedicines
+		SET
+			admitted_at = now(),
+			updated_at = now()
+		WHERE
+			telemedicine_id IN (SELECT telemedicine_id FROM queued)
+		RETURNING
+			telemedicine_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, doctorID, maxConcurrent, constant.PaymentConfirmed)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	ids := make([]uint, 0)
+	for rows.Next() {
+		var id uint
+		if err := rows.Scan(&id); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *telemedicineRepositoryImpl) CountQueuedBeforeID(ctx context.Context, doctorID uint, id uint) (int, error) {
+	q := `
+		SELECT
+			COUNT(*)
+		FROM
+			telemedicines
+		WHERE
+			doctor_id = $1
+		AND
+			telemedicine_id < $2
+		AND
+			admitted_at IS NULL
+		AND
+			end_at IS NULL
+		AND
+			status = $3
+		AND
+			deleted_at IS NULL
+	`
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, q, doctorID, id, constant.PaymentConfirmed).Scan(&total); err != nil {
+		logrus.Error(err)
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *telemedicineRepositoryImpl) SelectAverageDurationByDoctorID(ctx context.Context, doctorID uint) (*time.Duration, error) {
+	q := `
+		SELECT
+			EXTRACT(EPOCH FROM AVG(end_at - admitted_at))::FLOAT8
+		FROM (
+			SELECT
+				end_at, admitted_at
+			FROM
+				telemedicines
+			WHERE
+				doctor_id = $1
+			AND
+				admitted_at IS NOT NULL
+			AND
+				end_at > admitted_at
+			AND
+				status = $2
+			AND
+				deleted_at IS NULL
+			ORDER BY
+				end_at DESC
+			LIMIT $3
+		) recent
+	`
+
+	var seconds *float64
+	err := r.db.QueryRowContext(ctx, q, doctorID, constant.PaymentConfirmed, constant.ConsultationDurationSample).Scan(&seconds)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	if seconds == nil {
+		return nil, nil
+	}
+
+	duration := time.Duration(*seconds * float64(time.Second))
+	return &duration, nil
+}
+
+func (r *telemedicineRepositoryImpl) UpdateEndedByIdle(ctx context.Context, idleTimeout time.Duration) ([]entity.Telemedicine, error) {
+	q := `
+		UPDATE
+			telemedicines t
+		SET
+			end_at = now(),
+			session_status = $3,
+			updated_at = now()
+		WHERE
+			t.end_at IS NULL
+		AND
+			t.status = $1
+		AND
+			t.admitted_at <= now() - ($2::INT * INTERVAL '1 second')
+		AND
+			t.deleted_at IS NULL
+		AND
+			NOT EXISTS (
+				SELECT
+					1
+				FROM
+					message_bubbles mb
+				WHERE
+					mb.telemedicine_id = t.telemedicine_id
+				AND
+					mb.created_at > now() - ($2::INT * INTERVAL '1 second')
+				AND
+					mb.deleted_at IS NULL
+			)
+		RETURNING
+			t.telemedicine_id, t.user_id, t.doctor_id, t.end_at, t.status, t.session_status
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, constant.PaymentConfirmed, int(idleTimeout.Seconds()), constant.SessionEnded)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	telemedicines := make([]entity.Telemedicine, 0)
+	for rows.Next() {
+		var scan entity.Telemedicine
+		if err := rows.Scan(&scan.ID, &scan.User.ID, &scan.Doctor.ID, &scan.EndAt, &scan.Status, &scan.SessionStatus); err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		telemedicines = append(telemedicines, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return telemedicines, nil
+}
+
+func (r *telemedicineRepositoryImpl) SelectAllHistoryByPatient(ctx context.Context, userID uint, dependentID *uint, clc *entity.Collection) ([]entity.Telemedicine, error) {
+	selectColumns := `
+		t.telemedicine_id,
+		t.user_id,
+		t.dependent_id,
+		t.doctor_id,
+		d.doctor_name,
+		s.specialization_name,
+		t.start_at,
+		t.end_at,
+		t.session_status,
+		t.start_rest_at,
+		t.rest_duration,
+		t.medical_certificate_url,
+		t.prescription_certificate_url,
+		t.created_at
+	`
+	advanceQuery := `
+			telemedicines t
+		JOIN
+			doctors d ON d.doctor_id = t.doctor_id
+		JOIN
+			specializations s ON s.specialization_id = d.specialization_id
+		WHERE
+			t.user_id = $1
+		AND
+			t.end_at IS NOT NULL
+		AND
+			t.status = $2
+		AND
+			t.dependent_id IS NOT DISTINCT FROM $3::bigint
+		AND
+		%s
+	`
+
+	clc.Args = append(clc.Args, userID, constant.PaymentConfirmed, dependentID)
+	filter := utils.BuildFilterQuery(telemedicineColumnAlias, clc, "t.deleted_at IS NULL")
+
+	query := utils.BuildQuery(r.db, utils.PaginateQuery{
+		SelectColumns: selectColumns,
+		AdvanceQuery:  fmt.Sprintf(advanceQuery, filter),
+		OrderQuery:    "t.end_at DESC, t.telemedicine_id DESC",
+	}, clc)
+
+	rows, err := r.db.QueryContext(ctx, query, clc.Args...)
+	if err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	telemedicines := make([]entity.Telemedicine, 0)
+	for rows.Next() {
+		var scan entity.Telemedicine
+		err := rows.Scan(
+			&scan.ID,
+			&scan.User.ID,
+			&scan.DependentID,
+			&scan.Doctor.ID,
+			&scan.Doctor.Name,
+			&scan.Doctor.Specialization.Name,
+			&scan.StartAt,
+			&scan.EndAt,
+			&scan.SessionStatus,
+			&scan.StartRestAt,
+			&scan.RestDuration,
+			&scan.MedicalCertificateURL,
+			&scan.PrescriptionUrl,
+			&scan.CreatedAt,
+		)
+
+		if err != nil {
+			logrus.Error(err)
+			return nil, err
+		}
+
+		telemedicines = append(telemedicines, scan)
+	}
+
+	if err := rows.Err(); err != nil {
+		logrus.Error(err)
+		return nil, err
+	}
+
+	return telemedicines, nil
+}
@@ -41,7 +41,7 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 		userRouter.POST("/register/oauth", h.UserHandler.RegisterOAuth)
 		userRouter.POST("/login", h.UserHandler.Login)
 		userRouter.POST("/login/oauth", h.UserHandler.LoginOAuth)
-		userRouter.POST("/logout", h.UserHandler.Logout)
+		userRouter.POST("/refresh", h.UserHandler.Refresh)
 		userRouter.POST("/verification", h.UserHandler.Verification)
 		userRouter.POST("/forgot-password", h.UserHandler.ForgotPassword)
 		userRouter.POST("/reset-password", h.UserHandler.ResetPassword)
@@ -61,6 +61,7 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 		userRouter.GET("/categories", h.CategoryHandler.GetAllCategory)
 		userRouter.GET("/drugs", mwDoctorAdmin, h.DrugHandler.GetAll)
 		userRouter.GET("/drugs-of-the-day", h.PharmacyDrugHandler.GetDrugOfTheDay)
+		userRouter.GET("/documents/verify/:code", h.MedicalDocumentHandler.VerifyDocument)
 
 		userRouter.GET("/manufacturers", mwManagerAdmin, h.ManufacturerHandler.GetAllManufacturers)
 		userRouter.GET("/shipment-methods", mwManagerAdmin, h.ShipmentMethodHandler.GetAllShipmentMethods)
@@ -71,6 +72,16 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 			privateUserRouter.GET("/profile", h.UserHandler.GetProfile)
 			privateUserRouter.PUT("/profile", h.UserHandler.UpdatePersonal)
 			privateUserRouter.PUT("/update-password", h.UserHandler.UpdatePassword)
+			privateUserRouter.POST("/logout", h.UserHandler.Logout)
+			privateUserRouter.POST("/logout-all", h.UserHandler.LogoutAll)
+			privateUserRouter.GET("/health-profile", h.HealthProfileHandler.GetHealthProfile)
+			privateUserRouter.PUT("/health-profile", h.HealthProfileHandler.UpdateHealthProfile)
+
+			privateUserRouter.GET("/dependents", h.DependentHandler.GetAllDependents)
+			privateUserRouter.POST("/dependents", h.DependentHandler.AddDependent)
+			privateUserRouter.GET("/dependents/:id", h.DependentHandler.GetDependentByID)
+			privateUserRouter.PUT("/dependents/:id", h.DependentHandler.UpdateDependent)
+			privateUserRouter.DELETE("/dependents/:id", h.DependentHandler.DeleteDependent)
 
 			privateUserRouter.GET("/addresses", h.AddressHandler.GetAllAddress)
 			privateUserRouter.POST("/addresses", h.AddressHandler.AddAddress)
@@ -86,8 +97,12 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 
 			privateUserRouter.POST("/resend-verification", h.UserHandler.ResendVerification)
 
+			privateUserRouter.GET("/orders", h.OrderHandler.GetAllOrderByUser)
+			privateUserRouter.GET("/orders/:id", h.OrderHandler.GetOrderByUser)
 			privateUserRouter.POST("/orders", h.OrderHandler.CreateOrder)
 			privateUserRouter.PATCH("/orders/:id/confirm-order", h.OrderHandler.UpdateConfirmOrder)
+			privateUserRouter.PATCH("/orders/:id/dispute", h.OrderHandler.DisputeOrder)
+			privateUserRouter.GET("/orders/:id/timeline", h.OrderHandler.GetOrderTimeline)
 			privateUserRouter.GET("/payments", h.PaymentHandler.GetAllPaymentByUserId)
 			privateUserRouter.PATCH("/payments/:id/update-payment-proof", h.PaymentHandler.UpdatePaymentProof)
 			privateUserRouter.PATCH("/payments/:id/cancel-payment", h.PaymentHandler.UserCancelPayment)
@@ -101,7 +116,10 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 			privateChatRouter.Use(mwUserDoctor)
 			privateChatRouter.GET("", h.TelemedicineHandler.GetAllTelemedicine)
 			privateChatRouter.GET("/:id", h.TelemedicineHandler.GetTelemedicineByID)
+			privateChatRouter.GET("/:id/messages", h.MessageBubbleHandler.GetAllChat)
+			privateChatRouter.GET("/:id/stream", h.TelemedicineHandler.StreamTelemedicine)
 			privateChatRouter.POST("/chat", h.MessageBubbleHandler.AddChat)
+			privateChatRouter.POST("/:id/attachments", h.MessageBubbleHandler.AddAttachment)
 		}
 
 		privateDoctorChatRouter := chatRouter.Group("")
@@ -110,12 +128,18 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 			privateDoctorChatRouter.PUT("/:id", h.TelemedicineHandler.UpdateTelemedicine)
 			privateDoctorChatRouter.POST("/:id/certificate", h.TelemedicineHandler.GenerateMedicalCertificate)
 			privateDoctorChatRouter.POST("/:id/prescriptions", h.TelemedicineHandler.AddPrescriptions)
+			privateDoctorChatRouter.PUT("/:id/notes", h.TelemedicineHandler.SaveClinicalNote)
+			privateDoctorChatRouter.GET("/:id/patient-record", h.PatientRecordHandler.GetPatientRecord)
 		}
 
 		privateUserChatRouter := chatRouter.Group("")
 		{
 			privateUserChatRouter.Use(h.Middleware.UserAuth())
 			privateUserChatRouter.POST("", h.TelemedicineHandler.AddTelemedicine)
+			privateUserChatRouter.POST("/appointments", h.AppointmentHandler.BookAppointment)
+			privateUserChatRouter.GET("/:id/queue", h.TelemedicineHandler.GetQueue)
+			privateUserChatRouter.POST("/:id/review", h.DoctorReviewHandler.AddReview)
+			privateUserChatRouter.POST("/:id/redeem", h.CartItemHandler.RedeemPrescription)
 
 		}
 	}
@@ -126,10 +150,13 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 		doctorRouter.POST("/register/oauth", h.DoctorHandler.RegisterOAuth)
 		doctorRouter.POST("/login", h.DoctorHandler.Login)
 		doctorRouter.POST("/login/oauth", h.DoctorHandler.LoginOAuth)
+		doctorRouter.POST("/refresh", h.DoctorHandler.Refresh)
 		doctorRouter.POST("/forgot-password", h.DoctorHandler.ForgotPassword)
 		doctorRouter.POST("/verification", h.DoctorHandler.Verification)
 		doctorRouter.GET("", h.DoctorHandler.GetAllDoctors)
 		doctorRouter.GET("/:id", h.DoctorHandler.GetDoctorByID)
+		doctorRouter.GET("/:id/slots", h.DoctorScheduleHandler.GetAvailableSlots)
+		doctorRouter.GET("/:id/reviews", h.DoctorReviewHandler.GetAllDoctorReviews)
 
 		privateDoctorRouter := doctorRouter.Group("/")
 		{
@@ -137,17 +164,36 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 			privateDoctorRouter.GET("/profile", h.DoctorHandler.GetProfile)
 			privateDoctorRouter.PUT("/profile", h.DoctorHandler.UpdatePersonal)
 			privateDoctorRouter.PUT("/update-password", h.DoctorHandler.UpdatePassword)
+			privateDoctorRouter.POST("/logout", h.DoctorHandler.Logout)
+			privateDoctorRouter.POST("/logout-all", h.DoctorHandler.LogoutAll)
 			privateDoctorRouter.PUT("/update-status", h.DoctorHandler.UpdateStatus)
+
+			privateDoctorRouter.GET("/schedules", h.DoctorScheduleHandler.GetAllSchedules)
+			privateDoctorRouter.POST("/schedules", h.DoctorScheduleHandler.AddSchedule)
+			privateDoctorRouter.DELETE("/schedules/:id", h.DoctorScheduleHandler.DeleteSchedule)
+			privateDoctorRouter.GET("/schedule-exceptions", h.DoctorScheduleHandler.GetAllScheduleExceptions)
+			privateDoctorRouter.POST("/schedule-exceptions", h.DoctorScheduleHandler.AddScheduleException)
+			privateDoctorRouter.DELETE("/schedule-exceptions/:id", h.DoctorScheduleHandler.DeleteScheduleException)
+
+			privateDoctorRouter.GET("/appointments", h.AppointmentHandler.GetAllDoctorAppointments)
+			privateDoctorRouter.PATCH("/appointments/:id/cancel", h.AppointmentHandler.CancelAppointment)
+			privateDoctorRouter.PATCH("/appointments/:id/reschedule", h.AppointmentHandler.RescheduleAppointment)
+
+			privateDoctorRouter.GET("/icd10-codes", h.ICD10CodeHandler.GetAllCodes)
 		}
 	}
 
 	pharmacyManagerRouter := router.Group("/pharmacy-manager")
 	{
 		pharmacyManagerRouter.POST("/login", h.PharmacyManagerHandler.Login)
+		pharmacyManagerRouter.POST("/refresh", h.PharmacyManagerHandler.Refresh)
 		privateManagerRouter := pharmacyManagerRouter.Group("/")
 		{
 			privateManagerRouter.Use(h.Middleware.ManagerAuth())
 			privateManagerRouter.GET("/profile", h.PharmacyManagerHandler.GetProfile)
+			privateManagerRouter.PUT("/update-password", h.PharmacyManagerHandler.UpdatePassword)
+			privateManagerRouter.POST("/logout", h.PharmacyManagerHandler.Logout)
+			privateManagerRouter.POST("/logout-all", h.PharmacyManagerHandler.LogoutAll)
 			privateManagerRouter.GET("/drugs/:id", h.PharmacyDrugHandler.GetAllPharmacyDrugs)
 			privateManagerRouter.PUT("/drugs/edit/:id", h.PharmacyDrugHandler.UpdatePharmacyDrug)
 			privateManagerRouter.POST("/drugs/insert/:id", h.PharmacyDrugHandler.CreatePharmacyDrug)
@@ -155,6 +201,8 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 			privateManagerRouter.PATCH("/orders/:id/order-proceed", h.OrderHandler.OrderProceed)
 			privateManagerRouter.PATCH("/orders/:id/sent", h.OrderHandler.OrderSent)
 			privateManagerRouter.PATCH("/orders/:id/cancel", h.OrderHandler.OrderCancelByPM)
+			privateManagerRouter.PATCH("/orders/:id/resolve-dispute", h.OrderHandler.ResolveDispute)
+			privateManagerRouter.GET("/orders/:id/timeline", h.OrderHandler.GetOrderTimeline)
 
 			privateManagerRouter.POST("/drugs/insert", h.PharmacyDrugHandler.CreatePharmacyDrug)
 			privateManagerRouter.POST("/stock-mutation/request", h.StockRequestHandler.StockMutationManualRequest)
@@ -183,13 +231,18 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 	adminRouter := router.Group("/admin")
 	{
 		adminRouter.POST("/login", h.AdminHandler.Login)
+		adminRouter.POST("/refresh", h.AdminHandler.Refresh)
 
 		privateAdminRouter := adminRouter.Group("/")
 		{
 			privateAdminRouter.Use(h.Middleware.AdminAuth())
 			privateAdminRouter.GET("/profile", h.AdminHandler.GetProfile)
+			privateAdminRouter.PUT("/update-password", h.AdminHandler.UpdatePassword)
+			privateAdminRouter.POST("/logout", h.AdminHandler.Logout)
+			privateAdminRouter.POST("/logout-all", h.AdminHandler.LogoutAll)
 			privateAdminRouter.POST("/drugs", h.DrugHandler.InsertOne)
 			privateAdminRouter.PUT("/drugs/:id", h.DrugHandler.UpdateOne)
+			privateAdminRouter.POST("/drug-interactions/import", h.DrugInteractionHandler.ImportInteractions)
 
 			privateAdminRouter.GET("/partners", h.PartnerHandler.GetAll)
 			privateAdminRouter.POST("/partners", h.PartnerHandler.CreatePartner)
@@ -200,6 +253,8 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 			privateAdminRouter.PATCH("/payments/:id/confirm", h.PaymentHandler.PaymentConfirmation)
 			privateAdminRouter.PATCH("/payments/:id/cancel", h.PaymentHandler.AdminCancelPayment)
 			privateAdminRouter.PATCH("/payments/:id/reject", h.PaymentHandler.AdminRejectPayment)
+			privateAdminRouter.PATCH("/orders/:id/resolve-dispute", h.OrderHandler.ResolveDispute)
+			privateAdminRouter.GET("/orders/:id/timeline", h.OrderHandler.GetOrderTimeline)
 
 			privateAdminRouter.POST("/categories", h.CategoryHandler.CreateCategory)
 			privateAdminRouter.GET("/categories/:id", h.CategoryHandler.GetCategoryByID)
@@ -214,6 +269,9 @@ func SetupRouter(h *Handlers, fileLog io.Writer) *gin.Engine {
 
 			privateAdminRouter.GET("/reports/drugs", h.AdminReportHandler.GetAdminDrugReport)
 			privateAdminRouter.GET("/reports/categories", h.AdminReportHandler.GetAdminCategoryReport)
+
+			privateAdminRouter.GET("/doctor-reviews", h.DoctorReviewHandler.GetAllReviews)
+			privateAdminRouter.PATCH("/doctor-reviews/:id/visibility", h.DoctorReviewHandler.UpdateVisibility)
 		}
 	}
 
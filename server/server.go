@@ -1,14 +1,19 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"io"
 
+	"Alice-Seahat-Healthcare/seahat-be/config"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/handler"
 	"Alice-Seahat-Healthcare/seahat-be/libs/firebase"
+	"Alice-Seahat-Healthcare/seahat-be/libs/hub"
 	"Alice-Seahat-Healthcare/seahat-be/libs/mail"
 	"Alice-Seahat-Healthcare/seahat-be/libs/rajaongkir"
+	"Alice-Seahat-Healthcare/seahat-be/libs/scheduler"
 	"Alice-Seahat-Healthcare/seahat-be/libs/validator"
 	"Alice-Seahat-Healthcare/seahat-be/middleware"
 	"Alice-Seahat-Healthcare/seahat-be/repository"
@@ -43,6 +48,15 @@ type Handlers struct {
 	CategoryHandler        *handler.CategoryHandler
 	StockJournalHandler    *handler.StockJournalHandler
 	ManufacturerHandler    *handler.ManufacturerHandler
+	DoctorScheduleHandler  *handler.DoctorScheduleHandler
+	AppointmentHandler     *handler.AppointmentHandler
+	DoctorReviewHandler    *handler.DoctorReviewHandler
+	MedicalDocumentHandler *handler.MedicalDocumentHandler
+	ICD10CodeHandler       *handler.ICD10CodeHandler
+	PatientRecordHandler   *handler.PatientRecordHandler
+	HealthProfileHandler   *handler.HealthProfileHandler
+	DependentHandler       *handler.DependentHandler
+	DrugInteractionHandler *handler.DrugInteractionHandler
 }
 
 type Server struct {
@@ -52,6 +66,8 @@ type Server struct {
 	appLog     io.Writer
 	rajaOngkir rajaongkir.RajaOngkir
 	firebase   firebase.Firebase
+	hub        hub.Hub
+	scheduler  scheduler.Scheduler
 }
 
 func NewServer(
@@ -60,6 +76,8 @@ func NewServer(
 	appLog io.Writer,
 	rajaOngkir rajaongkir.RajaOngkir,
 	firebase firebase.Firebase,
+	hub hub.Hub,
+	scheduler scheduler.Scheduler,
 ) *Server {
 	return &Server{
 		transactor: transaction.NewTransactor(db),
@@ -68,6 +86,8 @@ func NewServer(
 		appLog:     appLog,
 		rajaOngkir: rajaOngkir,
 		firebase:   firebase,
+		hub:        hub,
+		scheduler:  scheduler,
 	}
 }
 
@@ -75,18 +95,20 @@ func (s Server) SetupServer() *gin.Engine {
 	validator.SetCustom(binding.Validator.Engine())
 
 	customHandler := handler.NewCustomHandler()
-	middleware := middleware.NewMiddleware()
 
 	drugRepository := repository.NewDrugRepository(s.db)
 	pharmacyDrugRepository := repository.NewPharmacyDrugRepository(s.db)
 	userRepository := repository.NewUserRepository(s.db)
 	tokenRepository := repository.NewTokenRepository(s.db)
+	refreshTokenRepository := repository.NewRefreshTokenRepository(s.db)
+	sessionRepository := repository.NewSessionRepository(s.db)
 	doctorRepository := repository.NewDoctorRepository(s.db)
 	pharmacyManagerRepository := repository.NewPharmacyManagerRepository(s.db)
 	adminRepository := repository.NewAdminRepository(s.db)
 	partnerRepository := repository.NewPartnerRepository(s.db)
 	telemedicineRepository := repository.NewTelemedicineRepository(s.db)
 	messageBubbleRepository := repository.NewMessageBubblesRepository(s.db)
+	messageAttachmentRepository := repository.NewMessageAttachmentRepository(s.db)
 	adminReportRepository := repository.NewAdminReportRepository(s.db)
 	specializationRepository := repository.NewSpecializationRepository(s.db)
 	addressRepository := repository.NewAddressRepository(s.db)
@@ -101,30 +123,86 @@ func (s Server) SetupServer() *gin.Engine {
 	shipmentMethodRepository := repository.NewShipmentMethodRepository(s.db, s.rajaOngkir)
 	categoryRepository := repository.NewCategoryRepository(s.db)
 	prescriptionRepository := repository.NewPrescriptionRepository(s.db)
+	prescriptionFillRepository := repository.NewPrescriptionFillRepository(s.db)
+	medicalDocumentRepository := repository.NewMedicalDocumentRepository(s.db)
+	clinicalNoteRepository := repository.NewClinicalNoteRepository(s.db)
+	icd10CodeRepository := repository.NewICD10CodeRepository(s.db)
+	patientRecordAccessLogRepository := repository.NewPatientRecordAccessLogRepository(s.db)
+	healthProfileRepository := repository.NewHealthProfileRepository(s.db)
+	drugInteractionRepository := repository.NewDrugInteractionRepository(s.db)
+	dependentRepository := repository.NewDependentRepository(s.db)
+	orderStatusHistoryRepository := repository.NewOrderStatusHistoryRepository(s.db)
 	manufacturerRepository := repository.NewManufacturerRepository(s.db)
+	doctorScheduleRepository := repository.NewDoctorScheduleRepository(s.db)
+	doctorScheduleExceptionRepository := repository.NewDoctorScheduleExceptionRepository(s.db)
+	appointmentRepository := repository.NewAppointmentRepository(s.db)
+	doctorReviewRepository := repository.NewDoctorReviewRepository(s.db)
+
+	middleware := middleware.NewMiddleware(sessionRepository)
 
 	drugUsecase := usecase.NewDrugUsecase(drugRepository, s.transactor)
-	userUsecase := usecase.NewUserUsecase(userRepository, doctorRepository, tokenRepository, s.transactor, s.mailDialer, s.firebase)
+	userUsecase := usecase.NewUserUsecase(userRepository, doctorRepository, tokenRepository, refreshTokenRepository, sessionRepository, s.transactor, s.mailDialer, s.firebase)
 	pharmacyDrugUsecase := usecase.NewPharmacyDrugUsecase(pharmacyDrugRepository, addressRepository, drugRepository, pharmacyRepository, categoryRepository, s.transactor, stockJournalRepository)
-	doctorUsecase := usecase.NewDoctorUsecase(doctorRepository, tokenRepository, s.transactor, s.mailDialer, s.firebase)
-	pharmacyManagerUsecase := usecase.NewPharmacyManagerUsecase(pharmacyManagerRepository, partnerRepository, s.transactor)
-	adminUsecase := usecase.NewAdminUsecase(userRepository, doctorRepository, pharmacyManagerRepository, adminRepository, s.transactor)
+	doctorUsecase := usecase.NewDoctorUsecase(doctorRepository, tokenRepository, refreshTokenRepository, sessionRepository, s.transactor, s.mailDialer, s.firebase)
+	pharmacyManagerUsecase := usecase.NewPharmacyManagerUsecase(pharmacyManagerRepository, partnerRepository, refreshTokenRepository, sessionRepository, s.transactor)
+	adminUsecase := usecase.NewAdminUsecase(userRepository, doctorRepository, pharmacyManagerRepository, adminRepository, refreshTokenRepository, sessionRepository, s.transactor)
 	uploadUsecase := usecase.NewUploadUsecase()
-	telemedicineUsecase := usecase.NewTelemedicineUsecase(telemedicineRepository, userRepository, doctorRepository, prescriptionRepository)
-	messageBubbleUsecase := usecase.NewMessageBubbleUsecase(messageBubbleRepository)
+	telemedicineUsecase := usecase.NewTelemedicineUsecase(telemedicineRepository, userRepository, doctorRepository, prescriptionRepository, prescriptionFillRepository, paymentRepository, messageBubbleRepository, medicalDocumentRepository, clinicalNoteRepository, healthProfileRepository, drugInteractionRepository, dependentRepository, s.transactor, s.hub, config.Telemedicine.MaxConcurrent)
+	messageBubbleUsecase := usecase.NewMessageBubbleUsecase(messageBubbleRepository, messageAttachmentRepository, telemedicineRepository, s.transactor, s.hub)
 	adminReportUsecase := usecase.NewAdminReportUsecase(adminReportRepository)
 	specializationUsecase := usecase.NewSpecializationUsecase(specializationRepository, s.transactor)
 	partnerUsecase := usecase.NewPartnerUsecase(pharmacyManagerRepository, partnerRepository, s.transactor, s.mailDialer)
 	addressUsecase := usecase.NewAddressUsecase(addressRepository, shipmentMethodRepository, s.transactor)
-	cartItemUsecase := usecase.NewCartItemUsecase(cartItemRepository, pharmacyDrugRepository, s.transactor)
-	orderUsecase := usecase.NewOrderUsecase(orderRepository, orderDetailRepository, s.transactor, paymentRepository, pharmacyDrugRepository, cartItemRepository, stockJournalRepository, stockRequestRepository, stockRequestDrugRepository, shipmentMethodRepository, addressRepository)
-	paymentUsecase := usecase.NewPaymentUsecase(paymentRepository, orderRepository, s.transactor)
+	cartItemUsecase := usecase.NewCartItemUsecase(cartItemRepository, pharmacyDrugRepository, drugRepository, telemedicineRepository, prescriptionRepository, addressRepository, s.transactor)
+	orderUsecase := usecase.NewOrderUsecase(orderRepository, orderDetailRepository, s.transactor, paymentRepository, pharmacyDrugRepository, cartItemRepository, stockJournalRepository, stockRequestRepository, stockRequestDrugRepository, shipmentMethodRepository, addressRepository, prescriptionRepository, prescriptionFillRepository, drugInteractionRepository, orderStatusHistoryRepository, config.Worker.OrderAutoConfirm)
+	paymentUsecase := usecase.NewPaymentUsecase(paymentRepository, orderRepository, telemedicineRepository, orderStatusHistoryRepository, orderDetailRepository, pharmacyDrugRepository, stockJournalRepository, s.transactor)
 	pharmacyUsecase := usecase.NewPharmacyUsecase(pharmacyRepository, shipmentMethodRepository, s.transactor)
 	stockRequestUsecase := usecase.NewStockRequestUsecase(pharmacyRepository, stockRequestRepository, stockRequestDrugRepository, s.transactor, pharmacyDrugRepository, stockJournalRepository)
 	shipmentMethodUsecase := usecase.NewShipmentMethodUsecase(shipmentMethodRepository, s.transactor)
 	categoryUsecase := usecase.NewCategoryUsecase(categoryRepository, s.transactor, pharmacyDrugRepository)
 	stockJournalUsecase := usecase.NewStockJournalUsecase(orderRepository, orderDetailRepository, s.transactor, paymentRepository, pharmacyDrugRepository, cartItemRepository, stockJournalRepository, stockRequestRepository, stockRequestDrugRepository)
 	manufacturerUsecase := usecase.NewManufacturerUsecase(manufacturerRepository)
+	doctorScheduleUsecase := usecase.NewDoctorScheduleUsecase(doctorScheduleRepository, doctorScheduleExceptionRepository, appointmentRepository, doctorRepository)
+	appointmentUsecase := usecase.NewAppointmentUsecase(appointmentRepository, doctorScheduleRepository, doctorScheduleExceptionRepository, telemedicineRepository, doctorRepository, paymentRepository, s.transactor)
+	doctorReviewUsecase := usecase.NewDoctorReviewUsecase(doctorReviewRepository, doctorRepository, telemedicineRepository, s.transactor)
+	medicalDocumentUsecase := usecase.NewMedicalDocumentUsecase(medicalDocumentRepository)
+	icd10CodeUsecase := usecase.NewICD10CodeUsecase(icd10CodeRepository)
+	healthProfileUsecase := usecase.NewHealthProfileUsecase(healthProfileRepository, s.transactor)
+	drugInteractionUsecase := usecase.NewDrugInteractionUsecase(drugInteractionRepository)
+	dependentUsecase := usecase.NewDependentUsecase(dependentRepository)
+	patientRecordUsecase := usecase.NewPatientRecordUsecase(telemedicineRepository, userRepository, prescriptionRepository, prescriptionFillRepository, clinicalNoteRepository, patientRecordAccessLogRepository, dependentRepository)
+
+	s.scheduler.Register(scheduler.Job{
+		Name:     constant.JobCloseIdleTelemedicine,
+		Interval: config.Worker.Interval,
+		Run: func(ctx context.Context) error {
+			return telemedicineUsecase.CloseIdleSessions(ctx, config.Worker.SessionIdleTimeout)
+		},
+	})
+
+	s.scheduler.Register(scheduler.Job{
+		Name:     constant.JobOfflineIdleDoctor,
+		Interval: config.Worker.Interval,
+		Run: func(ctx context.Context) error {
+			return doctorUsecase.UpdateIdleDoctorsOffline(ctx, config.Worker.DoctorIdleTimeout)
+		},
+	})
+
+	s.scheduler.Register(scheduler.Job{
+		Name:     constant.JobExpireUnpaidPayment,
+		Interval: config.Worker.Interval,
+		Run: func(ctx context.Context) error {
+			return paymentUsecase.ExpireUnpaidPayments(ctx)
+		},
+	})
+
+	s.scheduler.Register(scheduler.Job{
+		Name:     constant.JobAutoConfirmOrder,
+		Interval: config.Worker.Interval,
+		Run: func(ctx context.Context) error {
+			return orderUsecase.AutoConfirmOrders(ctx)
+		},
+	})
 
 	drugHandler := handler.NewDrugHandler(drugUsecase)
 	userHandler := handler.NewUserHandler(userUsecase)
@@ -148,6 +226,15 @@ func (s Server) SetupServer() *gin.Engine {
 	categoryHandler := handler.NewCategoryHandler(categoryUsecase)
 	stockJournalHandler := handler.NewStockJournalHandler(stockJournalUsecase)
 	manufacturerHandler := handler.NewManufacturerHandler(manufacturerUsecase)
+	doctorScheduleHandler := handler.NewDoctorScheduleHandler(doctorScheduleUsecase)
+	appointmentHandler := handler.NewAppointmentHandler(appointmentUsecase)
+	doctorReviewHandler := handler.NewDoctorReviewHandler(doctorReviewUsecase)
+	medicalDocumentHandler := handler.NewMedicalDocumentHandler(medicalDocumentUsecase)
+	icd10CodeHandler := handler.NewICD10CodeHandler(icd10CodeUsecase)
+	patientRecordHandler := handler.NewPatientRecordHandler(patientRecordUsecase)
+	healthProfileHandler := handler.NewHealthProfileHandler(healthProfileUsecase)
+	drugInteractionHandler := handler.NewDrugInteractionHandler(drugInteractionUsecase)
+	dependentHandler := handler.NewDependentHandler(dependentUsecase)
 
 	return SetupRouter(&Handlers{
 		CustomHandler:          customHandler,
@@ -174,5 +261,14 @@ func (s Server) SetupServer() *gin.Engine {
 		CategoryHandler:        categoryHandler,
 		StockJournalHandler:    stockJournalHandler,
 		ManufacturerHandler:    manufacturerHandler,
+		DoctorScheduleHandler:  doctorScheduleHandler,
+		AppointmentHandler:     appointmentHandler,
+		DoctorReviewHandler:    doctorReviewHandler,
+		MedicalDocumentHandler: medicalDocumentHandler,
+		ICD10CodeHandler:       icd10CodeHandler,
+		PatientRecordHandler:   patientRecordHandler,
+		HealthProfileHandler:   healthProfileHandler,
+		DrugInteractionHandler: drugInteractionHandler,
+		DependentHandler:       dependentHandler,
 	}, s.appLog)
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 	"Alice-Seahat-Healthcare/seahat-be/repository"
@@ -12,7 +13,11 @@ import (
 )
 
 type AdminUsecase interface {
-	Login(ctx context.Context, body entity.Admin) (string, error)
+	Login(ctx context.Context, body entity.Admin) (*entity.JwtToken, error)
+	Refresh(ctx context.Context, refreshToken string) (*entity.JwtToken, error)
+	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context) error
+	UpdatePassword(ctx context.Context, oldPwd string, newPwd string) error
 	GetProfile(ctx context.Context) (*entity.Admin, error)
 	GetAllUser(ctx context.Context, clc *entity.Collection) ([]entity.User, error)
 	GetAllDoctor(ctx context.Context, clc *entity.Collection) ([]entity.Doctor, error)
@@ -24,7 +29,9 @@ type adminUsecaseImpl struct {
 	doctorRepository          repository.DoctorRepository
 	pharmacyManagerRepository repository.PharmacyManagerRepository
 	adminRepository           repository.AdminRepository
+	sessionRepository         repository.SessionRepository
 	transactor                transaction.Transactor
+	jwtTokenIssuer            *jwtTokenIssuer
 }
 
 func NewAdminUsecase(
@@ -32,6 +39,8 @@ func NewAdminUsecase(
 	doctorRepository repository.DoctorRepository,
 	pharmacyManagerRepository repository.PharmacyManagerRepository,
 	adminRepository repository.AdminRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	sessionRepository repository.SessionRepository,
 	transactor transaction.Transactor,
 ) *adminUsecaseImpl {
 	return &adminUsecaseImpl{
@@ -39,35 +48,94 @@ func NewAdminUsecase(
 		doctorRepository:          doctorRepository,
 		pharmacyManagerRepository: pharmacyManagerRepository,
 		adminRepository:           adminRepository,
+		sessionRepository:         sessionRepository,
 		transactor:                transactor,
+		jwtTokenIssuer:            newJwtTokenIssuer(constant.Admin, utils.JwtGenerateAdmin, refreshTokenRepository, sessionRepository, transactor),
 	}
 }
 
-func (u *adminUsecaseImpl) Login(ctx context.Context, body entity.Admin) (string, error) {
+func (u *adminUsecaseImpl) Login(ctx context.Context, body entity.Admin) (*entity.JwtToken, error) {
 	admin, err := u.adminRepository.SelectOneByEmail(ctx, body.Email)
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
-			return "", apperror.InvalidCredential
+			return nil, apperror.InvalidCredential
 		}
 
-		return "", err
+		return nil, err
 	}
 
 	if !utils.HashCompareDefault(body.Password, &admin.Password) {
-		return "", apperror.InvalidCredential
+		return nil, apperror.InvalidCredential
+	}
+
+	return u.jwtTokenIssuer.issue(ctx, admin.ID, admin.Email)
+}
+
+func (u *adminUsecaseImpl) Refresh(ctx context.Context, refreshToken string) (*entity.JwtToken, error) {
+	return u.jwtTokenIssuer.rotate(ctx, refreshToken)
+}
+
+func (u *adminUsecaseImpl) Logout(ctx context.Context, refreshToken string) error {
+	sessionID := utils.CtxGetSessionID(ctx)
+	if sessionID == "" {
+		return apperror.ErrInternalServer
+	}
+
+	if err := u.sessionRepository.RevokeByID(ctx, sessionID); err != nil {
+		return err
 	}
 
-	jwtData := map[string]any{
-		"ID":    admin.ID,
-		"Email": admin.Email,
+	if refreshToken == "" {
+		return nil
+	}
+
+	return u.jwtTokenIssuer.revoke(ctx, refreshToken)
+}
+
+func (u *adminUsecaseImpl) LogoutAll(ctx context.Context) error {
+	actorCtx, ok := utils.CtxGetAdmin(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	return u.sessionRepository.RevokeByActor(ctx, constant.Admin, actorCtx.ID, "")
+}
+
+func (u *adminUsecaseImpl) UpdatePassword(ctx context.Context, oldPwd string, newPwd string) error {
+	actorCtx, ok := utils.CtxGetAdmin(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	admin, err := u.adminRepository.SelectOneByEmail(ctx, actorCtx.Email)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return apperror.ResourceNotFound
+		}
+
+		return err
+	}
+
+	if !utils.HashCompareDefault(oldPwd, &admin.Password) {
+		return apperror.InvalidPassword
+	}
+
+	hashPwd, err := utils.HashPasswordDefault(&newPwd)
+	if err != nil {
+		return err
+	}
+
+	err = u.adminRepository.UpdatePassword(ctx, admin.ID, *hashPwd)
+	if err != nil {
+		return err
 	}
 
-	token, err := utils.JwtGenerateAdmin(jwtData)
+	err = u.sessionRepository.RevokeByActor(ctx, constant.Admin, admin.ID, utils.CtxGetSessionID(ctx))
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	return token, nil
+	return nil
 }
 
 func (u *adminUsecaseImpl) GetProfile(ctx context.Context) (*entity.Admin, error) {
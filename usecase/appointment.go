@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type AppointmentUsecase interface {
+	BookAppointment(ctx context.Context, appointment entity.Appointment) (*entity.Appointment, error)
+	GetAllDoctorAppointments(ctx context.Context, clc *entity.Collection) ([]entity.Appointment, error)
+	CancelAppointment(ctx context.Context, id uint) error
+	RescheduleAppointment(ctx context.Context, id uint, startAt time.Time) (*entity.Appointment, error)
+}
+
+type appointmentUsecaseImpl struct {
+	appointmentRepository  repository.AppointmentRepository
+	telemedicineRepository repository.TelemedicineRepository
+	doctorRepository       repository.DoctorRepository
+	paymentRepository      repository.PaymentRepository
+	transactor             transaction.Transactor
+	slotFinder             *slotFinder
+}
+
+func NewAppointmentUsecase(
+	appointmentRepository repository.AppointmentRepository,
+	doctorScheduleRepository repository.DoctorScheduleRepository,
+	doctorScheduleExceptionRepository repository.DoctorScheduleExceptionRepository,
+	telemedicineRepository repository.TelemedicineRepository,
+	doctorRepository repository.DoctorRepository,
+	paymentRepository repository.PaymentRepository,
+	transactor transaction.Transactor,
+) *appointmentUsecaseImpl {
+	return &appointmentUsecaseImpl{
+		appointmentRepository:  appointmentRepository,
+		telemedicineRepository: telemedicineRepository,
+		doctorRepository:       doctorRepository,
+		paymentRepository:      paymentRepository,
+		transactor:             transactor,
+		slotFinder:             newSlotFinder(doctorScheduleRepository, doctorScheduleExceptionRepository, appointmentRepository),
+	}
+}
+
+func (u *appointmentUsecaseImpl) BookAppointment(ctx context.Context, appointment entity.Appointment) (*entity.Appointment, error) {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	doctor, err := u.doctorRepository.SelectOneByID(ctx, appointment.DoctorID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.DoctorNotExist
+		}
+
+		return nil, err
+	}
+
+	slot, err := u.slotFinder.findFree(ctx, doctor.ID, appointment.StartAt)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = u.telemedicineRepository.SelectOneOngoingByUserAndDoctorID(ctx, userCtx.ID, doctor.ID)
+	if !errors.Is(err, apperror.ErrResourceNotFound) {
+		if err == nil {
+			return nil, apperror.TelemedicineOngoingWithDoctor
+		}
+
+		return nil, err
+	}
+
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		payment, err := u.paymentRepository.InsertPayment(txCtx, &entity.Payment{
+			UserId:     userCtx.ID,
+			TotalPrice: int(doctor.Price),
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		telemedicine, err := u.telemedicineRepository.InsertOne(txCtx, entity.Telemedicine{
+			User:      entity.User{ID: userCtx.ID},
+			Doctor:    entity.Doctor{ID: doctor.ID},
+			Price:     int(doctor.Price),
+			PaymentID: &payment.Id,
+			Status:    constant.WaitingForPayment,
+			StartAt:   &slot.StartAt,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return u.appointmentRepository.InsertOne(txCtx, entity.Appointment{
+			DoctorID:       doctor.ID,
+			UserID:         userCtx.ID,
+			TelemedicineID: telemedicine.ID,
+			StartAt:        slot.StartAt,
+			EndAt:          slot.EndAt,
+			Status:         constant.AppointmentBooked,
+		})
+	})
+
+	if err != nil {
+		if errors.Is(err, apperror.ErrSlotUnavailable) {
+			return nil, apperror.SlotUnavailable
+		}
+
+		return nil, err
+	}
+
+	newAppointment, ok := data.(*entity.Appointment)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return newAppointment, nil
+}
+
+func (u *appointmentUsecaseImpl) GetAllDoctorAppointments(ctx context.Context, clc *entity.Collection) ([]entity.Appointment, error) {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return u.appointmentRepository.SelectAllByDoctorID(ctx, doctor.ID, clc)
+}
+
+func (u *appointmentUsecaseImpl) CancelAppointment(ctx context.Context, id uint) error {
+	appointment, err := u.getChangeableAppointment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	telemedicine, err := u.telemedicineRepository.SelectOneByID(ctx, appointment.TelemedicineID, nil, &appointment.DoctorID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return apperror.AppointmentCannotChange
+		}
+
+		return err
+	}
+
+	_, err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		if err := u.appointmentRepository.UpdateStatus(txCtx, appointment.ID, constant.Cancelled); err != nil {
+			return nil, err
+		}
+
+		if err := u.telemedicineRepository.UpdateCancelledByID(txCtx, appointment.TelemedicineID); err != nil {
+			return nil, err
+		}
+
+		if telemedicine.PaymentID == nil {
+			return nil, nil
+		}
+
+		_, err := u.paymentRepository.DoctorCancelPayment(txCtx, entity.Payment{Id: *telemedicine.PaymentID}, appointment.DoctorID)
+		return nil, err
+	})
+
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return apperror.AppointmentCannotChange
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (u *appointmentUsecaseImpl) RescheduleAppointment(ctx context.Context, id uint, startAt time.Time) (*entity.Appointment, error) {
+	appointment, err := u.getChangeableAppointment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := u.slotFinder.findFree(ctx, appointment.DoctorID, startAt)
+	if err != nil {
+		return nil, err
+	}
+
+	appointment.StartAt = slot.StartAt
+	appointment.EndAt = slot.EndAt
+
+	_, err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		if err := u.appointmentRepository.UpdateSchedule(txCtx, appointment.ID, appointment.StartAt, appointment.EndAt); err != nil {
+			return nil, err
+		}
+
+		return nil, u.telemedicineRepository.UpdateStartAt(txCtx, appointment.TelemedicineID, appointment.StartAt)
+	})
+
+	if err != nil {
+		if errors.Is(err, apperror.ErrSlotUnavailable) {
+			return nil, apperror.SlotUnavailable
+		}
+
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.AppointmentCannotChange
+		}
+
+		return nil, err
+	}
+
+	return appointment, nil
+}
+
+func (u *appointmentUsecaseImpl) getChangeableAppointment(ctx context.Context, id uint) (*entity.Appointment, error) {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	appointment, err := u.appointmentRepository.SelectOneByID(ctx, id, doctor.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	if appointment.Status != constant.AppointmentBooked || appointment.StartAt.Before(time.Now()) {
+		return nil, apperror.AppointmentCannotChange
+	}
+
+	return appointment, nil
+}
@@ -3,8 +3,10 @@ package usecase
 import (
 	"context"
 	"errors"
+	"time"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 	"Alice-Seahat-Healthcare/seahat-be/repository"
@@ -16,22 +18,35 @@ type CartItemUsecase interface {
 	AddCartItem(ctx context.Context, item entity.CartItem) (*entity.CartItem, error)
 	UpdateCartItem(ctx context.Context, item entity.CartItem) error
 	DeleteBulkCartItem(ctx context.Context, ids []uint) error
+	RedeemPrescription(ctx context.Context, telemedicineID uint) ([]entity.CartItem, []entity.Prescription, error)
 }
 
 type cartItemUsecaseImpl struct {
 	cartItemRepository     repository.CartItemRepository
 	pharmacyDrugRepository repository.PharmacyDrugRepository
+	drugRepository         repository.DrugRepository
+	telemedicineRepository repository.TelemedicineRepository
+	prescriptionRepository repository.PrescriptionRepository
+	addressRepository      repository.AddressRepository
 	transactor             transaction.Transactor
 }
 
 func NewCartItemUsecase(
 	cartItemRepository repository.CartItemRepository,
 	pharmacyDrugRepository repository.PharmacyDrugRepository,
+	drugRepository repository.DrugRepository,
+	telemedicineRepository repository.TelemedicineRepository,
+	prescriptionRepository repository.PrescriptionRepository,
+	addressRepository repository.AddressRepository,
 	transactor transaction.Transactor,
 ) *cartItemUsecaseImpl {
 	return &cartItemUsecaseImpl{
 		cartItemRepository:     cartItemRepository,
 		pharmacyDrugRepository: pharmacyDrugRepository,
+		drugRepository:         drugRepository,
+		telemedicineRepository: telemedicineRepository,
+		prescriptionRepository: prescriptionRepository,
+		addressRepository:      addressRepository,
 		transactor:             transactor,
 	}
 }
@@ -60,7 +75,22 @@ func (u *cartItemUsecaseImpl) AddCartItem(ctx context.Context, item entity.CartI
 		return nil, err
 	}
 
+	drug, err := u.drugRepository.SelectOneById(ctx, entity.Drug{ID: pd.DrugID})
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.DrugNotExist
+		}
+
+		return nil, err
+	}
+
+	if drug.Classification == constant.HardDrugClassification {
+		return nil, apperror.PrescriptionRequired
+	}
+
 	item.UserID = user.ID
+	item.IsPrescripted = false
+	item.PrescriptionID = nil
 
 	cartItem, err := u.cartItemRepository.GetByPrescriptedAndPharmacyDrugID(ctx, item)
 	if err != nil && !errors.Is(err, apperror.ErrResourceNotFound) {
@@ -149,3 +179,117 @@ func (u *cartItemUsecaseImpl) DeleteBulkCartItem(ctx context.Context, ids []uint
 
 	return nil
 }
+
+func (u *cartItemUsecaseImpl) RedeemPrescription(ctx context.Context, telemedicineID uint) ([]entity.CartItem, []entity.Prescription, error) {
+	user, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, nil, apperror.ErrInternalServer
+	}
+
+	if _, err := u.telemedicineRepository.SelectOneByID(ctx, telemedicineID, &user.ID, nil); err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, nil, apperror.ResourceNotFound
+		}
+
+		return nil, nil, err
+	}
+
+	prescriptions, err := u.prescriptionRepository.GetAllByTelemedicineID(ctx, telemedicineID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(prescriptions) == 0 {
+		return nil, nil, apperror.PrescriptionNotExist
+	}
+
+	addr := entity.Address{
+		Latitude:  constant.DefaultLatitude,
+		Longitude: constant.DefaultLongitude,
+	}
+
+	if mainAddr, _ := u.addressRepository.GetMainAddress(ctx, user.ID); mainAddr != nil {
+		addr.Latitude = mainAddr.Latitude
+		addr.Longitude = mainAddr.Longitude
+	}
+
+	items := make([]entity.CartItem, 0, len(prescriptions))
+	expired := make([]entity.Prescription, 0)
+	for _, prescription := range prescriptions {
+		if prescription.RedeemedQuantity >= prescription.Quantity {
+			continue
+		}
+
+		if time.Now().After(prescription.ExpiredAt) {
+			expired = append(expired, prescription)
+			continue
+		}
+
+		prescription.Quantity -= prescription.RedeemedQuantity
+		pd, err := u.findNearestStockedPharmacyDrug(ctx, prescription, addr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		prescriptionID := prescription.ID
+		items = append(items, entity.CartItem{
+			UserID:         user.ID,
+			PharmacyDrugID: pd.ID,
+			Quantity:       prescription.Quantity,
+			IsPrescripted:  true,
+			PrescriptionID: &prescriptionID,
+		})
+	}
+
+	if len(items) == 0 && len(expired) > 0 {
+		return nil, nil, apperror.PrescriptionExpired
+	}
+
+	if len(items) == 0 {
+		return nil, nil, apperror.PrescriptionRedeemed
+	}
+
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		cartItems := make([]entity.CartItem, 0, len(items))
+		for _, item := range items {
+			cartItem, err := u.cartItemRepository.InsertOne(txCtx, item)
+			if err != nil {
+				return nil, err
+			}
+
+			cartItems = append(cartItems, *cartItem)
+		}
+
+		return cartItems, nil
+	})
+
+	if err != nil {
+		if errors.Is(err, apperror.ErrPrescriptionRedeemed) {
+			return nil, nil, apperror.PrescriptionRedeemed
+		}
+
+		return nil, nil, err
+	}
+
+	cartItems, ok := data.([]entity.CartItem)
+	if !ok {
+		return nil, nil, apperror.ErrInternalServer
+	}
+
+	return cartItems, expired, nil
+}
+
+func (u *cartItemUsecaseImpl) findNearestStockedPharmacyDrug(ctx context.Context, prescription entity.Prescription, addr entity.Address) (*entity.PharmacyDrug, error) {
+	pharmacyDrugs, err := u.pharmacyDrugRepository.SelectNearestPharmaciesByDrugID(ctx, prescription.DrugID, addr, constant.SearchRadiusMetre, &entity.Collection{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pd := range pharmacyDrugs {
+		if pd.IsActive && pd.Stock >= prescription.Quantity {
+			return &pd, nil
+		}
+	}
+
+	return nil, apperror.PrescriptionDrugUnavailable
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type DependentUsecase interface {
+	GetAllDependents(ctx context.Context) ([]entity.Dependent, error)
+	GetDependentByID(ctx context.Context, id uint) (*entity.Dependent, error)
+	AddDependent(ctx context.Context, dependent entity.Dependent) (*entity.Dependent, error)
+	UpdateDependent(ctx context.Context, dependent entity.Dependent) (*entity.Dependent, error)
+	DeleteDependent(ctx context.Context, id uint) error
+}
+
+type dependentUsecaseImpl struct {
+	dependentRepository repository.DependentRepository
+}
+
+func NewDependentUsecase(dependentRepository repository.DependentRepository) *dependentUsecaseImpl {
+	return &dependentUsecaseImpl{
+		dependentRepository: dependentRepository,
+	}
+}
+
+func (u *dependentUsecaseImpl) GetAllDependents(ctx context.Context) ([]entity.Dependent, error) {
+	user, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return u.dependentRepository.SelectAllByUserID(ctx, user.ID)
+}
+
+func (u *dependentUsecaseImpl) GetDependentByID(ctx context.Context, id uint) (*entity.Dependent, error) {
+	user, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	dependent, err := u.dependentRepository.SelectOneByID(ctx, id, user.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	return dependent, nil
+}
+
+func (u *dependentUsecaseImpl) AddDependent(ctx context.Context, dependent entity.Dependent) (*entity.Dependent, error) {
+	user, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	if dependent.DateOfBirth.After(time.Now()) {
+		return nil, apperror.InvalidDateOfBirth
+	}
+
+	dependent.UserID = user.ID
+	return u.dependentRepository.InsertOne(ctx, dependent)
+}
+
+func (u *dependentUsecaseImpl) UpdateDependent(ctx context.Context, dependent entity.Dependent) (*entity.Dependent, error) {
+	user, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	if dependent.DateOfBirth.After(time.Now()) {
+		return nil, apperror.InvalidDateOfBirth
+	}
+
+	dependent.UserID = user.ID
+	updated, err := u.dependentRepository.UpdateOne(ctx, dependent)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (u *dependentUsecaseImpl) DeleteDependent(ctx context.Context, id uint) error {
+	user, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	if err := u.dependentRepository.DeleteOne(ctx, id, user.ID); err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return apperror.ResourceNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -16,8 +16,11 @@ import (
 )
 
 type DoctorUsecase interface {
-	Login(ctx context.Context, doctor entity.Doctor) (string, error)
-	LoginOAuth(ctx context.Context, googleToken string) (string, error)
+	Login(ctx context.Context, doctor entity.Doctor) (*entity.JwtToken, error)
+	LoginOAuth(ctx context.Context, googleToken string) (*entity.JwtToken, error)
+	Refresh(ctx context.Context, refreshToken string) (*entity.JwtToken, error)
+	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context) error
 	Register(ctx context.Context, body entity.Doctor) (*entity.Doctor, error)
 	RegisterOAuth(ctx context.Context, dr entity.Doctor, googleToken string) (*entity.Doctor, error)
 	CreateToken(ctx context.Context, doctor entity.Doctor, tokenType string) (*entity.Token, error)
@@ -29,89 +32,106 @@ type DoctorUsecase interface {
 	UpdateStatus(ctx context.Context, doctor entity.Doctor) error
 	GetDoctorByID(ctx context.Context, id uint) (*entity.Doctor, error)
 	GetAllDoctors(ctx context.Context, clc *entity.Collection) ([]entity.Doctor, error)
+	UpdateIdleDoctorsOffline(ctx context.Context, idleTimeout time.Duration) error
 }
 
 type doctorUsecaseImpl struct {
-	doctorRepository repository.DoctorRepository
-	tokenRepository  repository.TokenRepository
-	transactor       transaction.Transactor
-	mail             mail.MailDialer
-	firebase         firebase.Firebase
+	doctorRepository  repository.DoctorRepository
+	tokenRepository   repository.TokenRepository
+	sessionRepository repository.SessionRepository
+	transactor        transaction.Transactor
+	mail              mail.MailDialer
+	firebase          firebase.Firebase
+	jwtTokenIssuer    *jwtTokenIssuer
 }
 
 func NewDoctorUsecase(
 	doctorRepository repository.DoctorRepository,
 	tokenRepository repository.TokenRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	sessionRepository repository.SessionRepository,
 	transactor transaction.Transactor,
 	mail mail.MailDialer,
 	firebase firebase.Firebase,
 ) *doctorUsecaseImpl {
 	return &doctorUsecaseImpl{
-		doctorRepository: doctorRepository,
-		tokenRepository:  tokenRepository,
-		transactor:       transactor,
-		mail:             mail,
-		firebase:         firebase,
+		doctorRepository:  doctorRepository,
+		tokenRepository:   tokenRepository,
+		sessionRepository: sessionRepository,
+		transactor:        transactor,
+		mail:              mail,
+		firebase:          firebase,
+		jwtTokenIssuer:    newJwtTokenIssuer(constant.Doctor, utils.JwtGenerateDoctor, refreshTokenRepository, sessionRepository, transactor),
 	}
 }
 
-func (u *doctorUsecaseImpl) Login(ctx context.Context, body entity.Doctor) (string, error) {
+func (u *doctorUsecaseImpl) Login(ctx context.Context, body entity.Doctor) (*entity.JwtToken, error) {
 	doctor, err := u.doctorRepository.SelectOneByEmail(ctx, body.Email)
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
-			return "", apperror.InvalidCredential
+			return nil, apperror.InvalidCredential
 		}
 
-		return "", err
+		return nil, err
 	}
 
 	if !utils.HashCompareDefault(*body.Password, doctor.Password) {
-		return "", apperror.InvalidCredential
-	}
-
-	jwtData := map[string]any{
-		"ID":    doctor.ID,
-		"Email": doctor.Email,
-	}
-
-	token, err := utils.JwtGenerateDoctor(jwtData)
-	if err != nil {
-		return "", err
+		return nil, apperror.InvalidCredential
 	}
 
-	return token, nil
+	return u.jwtTokenIssuer.issue(ctx, doctor.ID, doctor.Email)
 }
 
-func (u *doctorUsecaseImpl) LoginOAuth(ctx context.Context, googleToken string) (string, error) {
+func (u *doctorUsecaseImpl) LoginOAuth(ctx context.Context, googleToken string) (*entity.JwtToken, error) {
 	googleUser, err := u.firebase.GetAuthIdentity(ctx, googleToken)
 	if err != nil {
-		return "", apperror.InvalidToken
+		return nil, apperror.InvalidToken
 	}
 
 	doctor, err := u.doctorRepository.SelectOneByEmail(ctx, googleUser["Email"])
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
-			return "", apperror.EmailOAuthNotFound
+			return nil, apperror.EmailOAuthNotFound
 		}
 
-		return "", err
+		return nil, err
 	}
 
 	if !doctor.IsOAuth {
-		return "", apperror.InvalidToken
+		return nil, apperror.InvalidToken
 	}
 
-	jwtData := map[string]any{
-		"ID":    doctor.ID,
-		"Email": doctor.Email,
+	return u.jwtTokenIssuer.issue(ctx, doctor.ID, doctor.Email)
+}
+
+func (u *doctorUsecaseImpl) Refresh(ctx context.Context, refreshToken string) (*entity.JwtToken, error) {
+	return u.jwtTokenIssuer.rotate(ctx, refreshToken)
+}
+
+func (u *doctorUsecaseImpl) Logout(ctx context.Context, refreshToken string) error {
+	sessionID := utils.CtxGetSessionID(ctx)
+	if sessionID == "" {
+		return apperror.ErrInternalServer
 	}
 
-	token, err := utils.JwtGenerateDoctor(jwtData)
-	if err != nil {
-		return "", err
+	if err := u.sessionRepository.RevokeByID(ctx, sessionID); err != nil {
+		return err
 	}
 
-	return token, nil
+	if refreshToken == "" {
+		return nil
+	}
+
+	return u.jwtTokenIssuer.revoke(ctx, refreshToken)
+}
+
+func (u *doctorUsecaseImpl) LogoutAll(ctx context.Context) error {
+	doctorCtx, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	return u.sessionRepository.RevokeByActor(ctx, constant.Doctor, doctorCtx.ID, "")
 }
 
 func (u *doctorUsecaseImpl) Register(ctx context.Context, body entity.Doctor) (*entity.Doctor, error) {
@@ -297,6 +317,11 @@ func (u *doctorUsecaseImpl) UpdatePassword(ctx context.Context, oldPwd string, n
 		return err
 	}
 
+	err = u.sessionRepository.RevokeByActor(ctx, constant.Doctor, doctor.ID, utils.CtxGetSessionID(ctx))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -345,6 +370,14 @@ func (u *doctorUsecaseImpl) UpdateStatus(ctx context.Context, doctor entity.Doct
 	return nil
 }
 
+func (u *doctorUsecaseImpl) UpdateIdleDoctorsOffline(ctx context.Context, idleTimeout time.Duration) error {
+	if _, err := u.doctorRepository.UpdateOfflineByIdle(ctx, idleTimeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (u *doctorUsecaseImpl) getDoctorByEmail(ctx context.Context, email string) (*entity.Doctor, error) {
 	d, err := u.doctorRepository.SelectOneByEmail(ctx, email)
 	if err != nil {
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type DoctorReviewUsecase interface {
+	AddReview(ctx context.Context, review entity.DoctorReview) (*entity.DoctorReview, error)
+	GetAllDoctorReviews(ctx context.Context, doctorID uint, clc *entity.Collection) ([]entity.DoctorReview, error)
+	GetAllReviews(ctx context.Context, clc *entity.Collection) ([]entity.DoctorReview, error)
+	UpdateHidden(ctx context.Context, id uint, isHidden bool) (*entity.DoctorReview, error)
+}
+
+type doctorReviewUsecaseImpl struct {
+	doctorReviewRepository repository.DoctorReviewRepository
+	doctorRepository       repository.DoctorRepository
+	telemedicineRepository repository.TelemedicineRepository
+	transactor             transaction.Transactor
+}
+
+func NewDoctorReviewUsecase(
+	doctorReviewRepository repository.DoctorReviewRepository,
+	doctorRepository repository.DoctorRepository,
+	telemedicineRepository repository.TelemedicineRepository,
+	transactor transaction.Transactor,
+) *doctorReviewUsecaseImpl {
+	return &doctorReviewUsecaseImpl{
+		doctorReviewRepository: doctorReviewRepository,
+		doctorRepository:       doctorRepository,
+		telemedicineRepository: telemedicineRepository,
+		transactor:             transactor,
+	}
+}
+
+func (u *doctorReviewUsecaseImpl) AddReview(ctx context.Context, review entity.DoctorReview) (*entity.DoctorReview, error) {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	telemedicine, err := u.telemedicineRepository.SelectOneByID(ctx, review.TelemedicineID, &userCtx.ID, nil)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	if telemedicine.EndAt == nil || telemedicine.Status != constant.PaymentConfirmed {
+		return nil, apperror.TelemedicineNotEnded
+	}
+
+	review.User.ID = userCtx.ID
+	review.DoctorID = telemedicine.Doctor.ID
+
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		newReview, err := u.doctorReviewRepository.InsertOne(txCtx, review)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := u.doctorRepository.UpdateRatingByID(txCtx, newReview.DoctorID); err != nil {
+			return nil, err
+		}
+
+		return newReview, nil
+	})
+
+	if err != nil {
+		if errors.Is(err, apperror.ErrReviewAlreadyExists) {
+			return nil, apperror.ReviewAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	newReview, ok := data.(*entity.DoctorReview)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return newReview, nil
+}
+
+func (u *doctorReviewUsecaseImpl) GetAllDoctorReviews(ctx context.Context, doctorID uint, clc *entity.Collection) ([]entity.DoctorReview, error) {
+	if _, err := u.doctorRepository.SelectOneByID(ctx, doctorID); err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.DoctorNotExist
+		}
+
+		return nil, err
+	}
+
+	return u.doctorReviewRepository.SelectAllVisibleByDoctorID(ctx, doctorID, clc)
+}
+
+func (u *doctorReviewUsecaseImpl) GetAllReviews(ctx context.Context, clc *entity.Collection) ([]entity.DoctorReview, error) {
+	return u.doctorReviewRepository.SelectAll(ctx, clc)
+}
+
+func (u *doctorReviewUsecaseImpl) UpdateHidden(ctx context.Context, id uint, isHidden bool) (*entity.DoctorReview, error) {
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		review, err := u.doctorReviewRepository.UpdateHiddenByID(txCtx, id, isHidden)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := u.doctorRepository.UpdateRatingByID(txCtx, review.DoctorID); err != nil {
+			return nil, err
+		}
+
+		return review, nil
+	})
+
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	review, ok := data.(*entity.DoctorReview)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return review, nil
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type DoctorScheduleUsecase interface {
+	GetAllSchedules(ctx context.Context) ([]entity.DoctorSchedule, error)
+	AddSchedule(ctx context.Context, schedule entity.DoctorSchedule) (*entity.DoctorSchedule, error)
+	DeleteSchedule(ctx context.Context, id uint) error
+	GetAllScheduleExceptions(ctx context.Context) ([]entity.DoctorScheduleException, error)
+	AddScheduleException(ctx context.Context, exception entity.DoctorScheduleException) (*entity.DoctorScheduleException, error)
+	DeleteScheduleException(ctx context.Context, id uint) error
+	GetAvailableSlots(ctx context.Context, doctorID uint, date time.Time) ([]entity.Slot, error)
+}
+
+type doctorScheduleUsecaseImpl struct {
+	doctorScheduleRepository          repository.DoctorScheduleRepository
+	doctorScheduleExceptionRepository repository.DoctorScheduleExceptionRepository
+	doctorRepository                  repository.DoctorRepository
+	slotFinder                        *slotFinder
+}
+
+func NewDoctorScheduleUsecase(
+	doctorScheduleRepository repository.DoctorScheduleRepository,
+	doctorScheduleExceptionRepository repository.DoctorScheduleExceptionRepository,
+	appointmentRepository repository.AppointmentRepository,
+	doctorRepository repository.DoctorRepository,
+) *doctorScheduleUsecaseImpl {
+	return &doctorScheduleUsecaseImpl{
+		doctorScheduleRepository:          doctorScheduleRepository,
+		doctorScheduleExceptionRepository: doctorScheduleExceptionRepository,
+		doctorRepository:                  doctorRepository,
+		slotFinder:                        newSlotFinder(doctorScheduleRepository, doctorScheduleExceptionRepository, appointmentRepository),
+	}
+}
+
+func (u *doctorScheduleUsecaseImpl) GetAllSchedules(ctx context.Context) ([]entity.DoctorSchedule, error) {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return u.doctorScheduleRepository.SelectAllByDoctorID(ctx, doctor.ID)
+}
+
+func (u *doctorScheduleUsecaseImpl) AddSchedule(ctx context.Context, schedule entity.DoctorSchedule) (*entity.DoctorSchedule, error) {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	window := clockOffset(schedule.EndTime) - clockOffset(schedule.StartTime)
+	if window < time.Duration(schedule.SlotDuration)*time.Minute {
+		return nil, apperror.InvalidScheduleTime
+	}
+
+	schedule.DoctorID = doctor.ID
+	newSchedule, err := u.doctorScheduleRepository.InsertOne(ctx, schedule)
+	if err != nil {
+		if errors.Is(err, apperror.ErrScheduleOverlap) {
+			return nil, apperror.ScheduleOverlap
+		}
+
+		return nil, err
+	}
+
+	return newSchedule, nil
+}
+
+func (u *doctorScheduleUsecaseImpl) DeleteSchedule(ctx context.Context, id uint) error {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	err := u.doctorScheduleRepository.DeleteOne(ctx, id, doctor.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return apperror.ResourceNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (u *doctorScheduleUsecaseImpl) GetAllScheduleExceptions(ctx context.Context) ([]entity.DoctorScheduleException, error) {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return u.doctorScheduleExceptionRepository.SelectAllUpcomingByDoctorID(ctx, doctor.ID)
+}
+
+func (u *doctorScheduleUsecaseImpl) AddScheduleException(ctx context.Context, exception entity.DoctorScheduleException) (*entity.DoctorScheduleException, error) {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	if exception.StartTime != nil && exception.EndTime != nil && clockOffset(*exception.StartTime) >= clockOffset(*exception.EndTime) {
+		return nil, apperror.InvalidScheduleTime
+	}
+
+	exception.DoctorID = doctor.ID
+	return u.doctorScheduleExceptionRepository.InsertOne(ctx, exception)
+}
+
+func (u *doctorScheduleUsecaseImpl) DeleteScheduleException(ctx context.Context, id uint) error {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	err := u.doctorScheduleExceptionRepository.DeleteOne(ctx, id, doctor.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return apperror.ResourceNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (u *doctorScheduleUsecaseImpl) GetAvailableSlots(ctx context.Context, doctorID uint, date time.Time) ([]entity.Slot, error) {
+	_, err := u.doctorRepository.SelectOneByID(ctx, doctorID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.DoctorNotExist
+		}
+
+		return nil, err
+	}
+
+	return u.slotFinder.find(ctx, doctorID, date)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+)
+
+type DrugInteractionUsecase interface {
+	ImportDrugInteractions(ctx context.Context, interactions []entity.DrugInteraction) (int, error)
+}
+
+type drugInteractionUsecaseImpl struct {
+	drugInteractionRepository repository.DrugInteractionRepository
+}
+
+func NewDrugInteractionUsecase(drugInteractionRepository repository.DrugInteractionRepository) *drugInteractionUsecaseImpl {
+	return &drugInteractionUsecaseImpl{
+		drugInteractionRepository: drugInteractionRepository,
+	}
+}
+
+func (u *drugInteractionUsecaseImpl) ImportDrugInteractions(ctx context.Context, interactions []entity.DrugInteraction) (int, error) {
+	pairs := make(map[string]int)
+	unique := make([]entity.DrugInteraction, 0)
+	for _, interaction := range interactions {
+		interaction.IngredientA = strings.TrimSpace(interaction.IngredientA)
+		interaction.IngredientB = strings.TrimSpace(interaction.IngredientB)
+
+		a, b := strings.ToLower(interaction.IngredientA), strings.ToLower(interaction.IngredientB)
+		if a > b {
+			a, b = b, a
+		}
+
+		key := a + "|" + b
+		if index, ok := pairs[key]; ok {
+			unique[index] = interaction
+			continue
+		}
+
+		pairs[key] = len(unique)
+		unique = append(unique, interaction)
+	}
+
+	err := u.drugInteractionRepository.UpsertMany(ctx, unique)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(unique), nil
+}
+
+func checkDrugInteractions(ctx context.Context, drugInteractionRepository repository.DrugInteractionRepository, newDrugIDs []uint, existingDrugIDs []uint) ([]entity.DrugInteractionWarning, error) {
+	isNew := make(map[uint]bool)
+	drugIDs := make([]uint, 0)
+	for _, id := range newDrugIDs {
+		if !isNew[id] {
+			drugIDs = append(drugIDs, id)
+		}
+		isNew[id] = true
+	}
+
+	for _, id := range existingDrugIDs {
+		if !isNew[id] {
+			drugIDs = append(drugIDs, id)
+		}
+	}
+
+	warnings := make([]entity.DrugInteractionWarning, 0)
+	if len(drugIDs) < 2 {
+		return warnings, nil
+	}
+
+	interactions, err := drugInteractionRepository.SelectAllByDrugIDs(ctx, drugIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, interaction := range interactions {
+		if !isNew[interaction.DrugA.ID] && !isNew[interaction.DrugB.ID] {
+			continue
+		}
+
+		switch interaction.Interaction.Severity {
+		case constant.InteractionSevere:
+			return nil, apperror.SevereDrugInteraction
+		case constant.InteractionModerate:
+			warnings = append(warnings, interaction)
+		}
+	}
+
+	return warnings, nil
+}
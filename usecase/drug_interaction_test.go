@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+type fakeDrugInteractionRepository struct {
+	warnings     []entity.DrugInteractionWarning
+	selectedIDs  []uint
+	selectCalled bool
+	upserted     []entity.DrugInteraction
+	upsertErr    error
+}
+
+func (r *fakeDrugInteractionRepository) SelectAllByDrugIDs(ctx context.Context, drugIDs []uint) ([]entity.DrugInteractionWarning, error) {
+	r.selectCalled = true
+	r.selectedIDs = drugIDs
+	return r.warnings, nil
+}
+
+func (r *fakeDrugInteractionRepository) UpsertMany(ctx context.Context, interactions []entity.DrugInteraction) error {
+	r.upserted = interactions
+	return r.upsertErr
+}
+
+func newInteractionWarning(drugA uint, drugB uint, severity string) entity.DrugInteractionWarning {
+	return entity.DrugInteractionWarning{
+		Interaction: entity.DrugInteraction{Severity: severity},
+		DrugA:       entity.Drug{ID: drugA},
+		DrugB:       entity.Drug{ID: drugB},
+	}
+}
+
+func TestCheckDrugInteractions(t *testing.T) {
+	tests := []struct {
+		name            string
+		newDrugIDs      []uint
+		existingDrugIDs []uint
+		warnings        []entity.DrugInteractionWarning
+		wantSelected    []uint
+		wantWarnings    []entity.DrugInteractionWarning
+		wantErr         error
+	}{
+		{
+			name:         "single drug skips the lookup",
+			newDrugIDs:   []uint{1},
+			wantWarnings: []entity.DrugInteractionWarning{},
+		},
+		{
+			name:         "duplicated new drug counts once",
+			newDrugIDs:   []uint{1, 1},
+			wantWarnings: []entity.DrugInteractionWarning{},
+		},
+		{
+			name:            "existing drug already in the new list is deduplicated",
+			newDrugIDs:      []uint{1, 2, 2},
+			existingDrugIDs: []uint{2, 3},
+			wantSelected:    []uint{1, 2, 3},
+			wantWarnings:    []entity.DrugInteractionWarning{},
+		},
+		{
+			name:            "moderate interaction becomes a warning",
+			newDrugIDs:      []uint{1},
+			existingDrugIDs: []uint{2},
+			warnings:        []entity.DrugInteractionWarning{newInteractionWarning(1, 2, constant.InteractionModerate)},
+			wantSelected:    []uint{1, 2},
+			wantWarnings:    []entity.DrugInteractionWarning{newInteractionWarning(1, 2, constant.InteractionModerate)},
+		},
+		{
+			name:         "minor interaction is ignored",
+			newDrugIDs:   []uint{1, 2},
+			warnings:     []entity.DrugInteractionWarning{newInteractionWarning(1, 2, constant.InteractionMinor)},
+			wantSelected: []uint{1, 2},
+			wantWarnings: []entity.DrugInteractionWarning{},
+		},
+		{
+			name:         "severe interaction is rejected",
+			newDrugIDs:   []uint{1, 2},
+			warnings:     []entity.DrugInteractionWarning{newInteractionWarning(1, 2, constant.InteractionSevere)},
+			wantSelected: []uint{1, 2},
+			wantErr:      apperror.SevereDrugInteraction,
+		},
+		{
+			name:            "interaction between existing drugs only is ignored",
+			newDrugIDs:      []uint{1},
+			existingDrugIDs: []uint{2, 3},
+			warnings:        []entity.DrugInteractionWarning{newInteractionWarning(2, 3, constant.InteractionSevere)},
+			wantSelected:    []uint{1, 2, 3},
+			wantWarnings:    []entity.DrugInteractionWarning{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeDrugInteractionRepository{warnings: tt.warnings}
+
+			warnings, err := checkDrugInteractions(context.Background(), repo, tt.newDrugIDs, tt.existingDrugIDs)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantSelected == nil && repo.selectCalled {
+				t.Errorf("SelectAllByDrugIDs called with %v, want no lookup", repo.selectedIDs)
+			}
+
+			if tt.wantSelected != nil && !reflect.DeepEqual(repo.selectedIDs, tt.wantSelected) {
+				t.Errorf("SelectAllByDrugIDs called with %v, want %v", repo.selectedIDs, tt.wantSelected)
+			}
+
+			if !reflect.DeepEqual(warnings, tt.wantWarnings) {
+				t.Errorf("warnings = %v, want %v", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestImportDrugInteractions(t *testing.T) {
+	tests := []struct {
+		name         string
+		interactions []entity.DrugInteraction
+		want         []entity.DrugInteraction
+	}{
+		{
+			name: "ingredients are trimmed",
+			interactions: []entity.DrugInteraction{
+				{IngredientA: "  Warfarin ", IngredientB: "Aspirin\t", Severity: constant.InteractionSevere},
+			},
+			want: []entity.DrugInteraction{
+				{IngredientA: "Warfarin", IngredientB: "Aspirin", Severity: constant.InteractionSevere},
+			},
+		},
+		{
+			name: "reversed pair with different case is a duplicate and the last row wins",
+			interactions: []entity.DrugInteraction{
+				{IngredientA: "Warfarin", IngredientB: "Aspirin", Severity: constant.InteractionModerate},
+				{IngredientA: "Ibuprofen", IngredientB: "Aspirin", Severity: constant.InteractionMinor},
+				{IngredientA: "aspirin", IngredientB: "WARFARIN", Severity: constant.InteractionSevere},
+			},
+			want: []entity.DrugInteraction{
+				{IngredientA: "aspirin", IngredientB: "WARFARIN", Severity: constant.InteractionSevere},
+				{IngredientA: "Ibuprofen", IngredientB: "Aspirin", Severity: constant.InteractionMinor},
+			},
+		},
+		{
+			name: "different pairs are kept in order",
+			interactions: []entity.DrugInteraction{
+				{IngredientA: "Warfarin", IngredientB: "Aspirin", Severity: constant.InteractionSevere},
+				{IngredientA: "Warfarin", IngredientB: "Ibuprofen", Severity: constant.InteractionModerate},
+			},
+			want: []entity.DrugInteraction{
+				{IngredientA: "Warfarin", IngredientB: "Aspirin", Severity: constant.InteractionSevere},
+				{IngredientA: "Warfarin", IngredientB: "Ibuprofen", Severity: constant.InteractionModerate},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeDrugInteractionRepository{}
+			u := NewDrugInteractionUsecase(repo)
+
+			count, err := u.ImportDrugInteractions(context.Background(), tt.interactions)
+			if err != nil {
+				t.Fatalf("err = %v, want nil", err)
+			}
+
+			if count != len(tt.want) {
+				t.Errorf("count = %d, want %d", count, len(tt.want))
+			}
+
+			if !reflect.DeepEqual(repo.upserted, tt.want) {
+				t.Errorf("upserted = %v, want %v", repo.upserted, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportDrugInteractionsRepositoryError(t *testing.T) {
+	upsertErr := errors.New("upsert failed")
+	repo := &fakeDrugInteractionRepository{upsertErr: upsertErr}
+	u := NewDrugInteractionUsecase(repo)
+
+	count, err := u.ImportDrugInteractions(context.Background(), []entity.DrugInteraction{
+		{IngredientA: "Warfarin", IngredientB: "Aspirin", Severity: constant.InteractionSevere},
+	})
+	if !errors.Is(err, upsertErr) {
+		t.Errorf("err = %v, want %v", err, upsertErr)
+	}
+
+	if count != 0 {
+		t.Errorf("count = %d, want 0", count)
+	}
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type HealthProfileUsecase interface {
+	GetHealthProfile(ctx context.Context) (*entity.HealthProfile, error)
+	UpdateHealthProfile(ctx context.Context, profile entity.HealthProfile) (*entity.HealthProfile, error)
+}
+
+type healthProfileUsecaseImpl struct {
+	healthProfileRepository repository.HealthProfileRepository
+	transactor              transaction.Transactor
+}
+
+func NewHealthProfileUsecase(
+	healthProfileRepository repository.HealthProfileRepository,
+	transactor transaction.Transactor,
+) *healthProfileUsecaseImpl {
+	return &healthProfileUsecaseImpl{
+		healthProfileRepository: healthProfileRepository,
+		transactor:              transactor,
+	}
+}
+
+func (u *healthProfileUsecaseImpl) GetHealthProfile(ctx context.Context) (*entity.HealthProfile, error) {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	profile, err := u.healthProfileRepository.SelectOneByUserID(ctx, userCtx.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return &entity.HealthProfile{
+				UserID:            userCtx.ID,
+				Allergies:         []entity.Allergy{},
+				ChronicConditions: []entity.ChronicCondition{},
+				Medications:       []entity.Medication{},
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (u *healthProfileUsecaseImpl) UpdateHealthProfile(ctx context.Context, profile entity.HealthProfile) (*entity.HealthProfile, error) {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	profile.UserID = userCtx.ID
+
+	_, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		_, err := u.healthProfileRepository.UpsertOne(txCtx, profile)
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.healthProfileRepository.DeleteDetailsByUserID(txCtx, profile.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(profile.Allergies) > 0 {
+			err = u.healthProfileRepository.InsertManyAllergies(txCtx, profile.UserID, profile.Allergies)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(profile.ChronicConditions) > 0 {
+			err = u.healthProfileRepository.InsertManyChronicConditions(txCtx, profile.UserID, profile.ChronicConditions)
+			if err != nil {
+				if errors.Is(err, apperror.ErrICD10CodeNotFound) {
+					return nil, apperror.ICD10CodeNotFound
+				}
+
+				return nil, err
+			}
+		}
+
+		if len(profile.Medications) > 0 {
+			err = u.healthProfileRepository.InsertManyMedications(txCtx, profile.UserID, profile.Medications)
+			if err != nil {
+				if errors.Is(err, apperror.ErrDrugNotExist) {
+					return nil, apperror.DrugNotExist
+				}
+
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.healthProfileRepository.SelectOneByUserID(ctx, profile.UserID)
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+)
+
+type ICD10CodeUsecase interface {
+	GetAllCodes(ctx context.Context, clc *entity.Collection) ([]entity.ICD10Code, error)
+}
+
+type icd10CodeUsecaseImpl struct {
+	icd10CodeRepository repository.ICD10CodeRepository
+}
+
+func NewICD10CodeUsecase(icd10CodeRepository repository.ICD10CodeRepository) *icd10CodeUsecaseImpl {
+	return &icd10CodeUsecaseImpl{
+		icd10CodeRepository: icd10CodeRepository,
+	}
+}
+
+func (u *icd10CodeUsecaseImpl) GetAllCodes(ctx context.Context, clc *entity.Collection) ([]entity.ICD10Code, error) {
+	return u.icd10CodeRepository.SelectAll(ctx, clc)
+}
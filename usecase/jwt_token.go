@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type jwtTokenIssuer struct {
+	actorType              string
+	jwtGenerate            func(data any, jti string) (string, error)
+	refreshTokenRepository repository.RefreshTokenRepository
+	sessionRepository      repository.SessionRepository
+	transactor             transaction.Transactor
+}
+
+func newJwtTokenIssuer(
+	actorType string,
+	jwtGenerate func(data any, jti string) (string, error),
+	refreshTokenRepository repository.RefreshTokenRepository,
+	sessionRepository repository.SessionRepository,
+	transactor transaction.Transactor,
+) *jwtTokenIssuer {
+	return &jwtTokenIssuer{
+		actorType:              actorType,
+		jwtGenerate:            jwtGenerate,
+		refreshTokenRepository: refreshTokenRepository,
+		sessionRepository:      sessionRepository,
+		transactor:             transactor,
+	}
+}
+
+func (i *jwtTokenIssuer) issue(ctx context.Context, id uint, email string) (*entity.JwtToken, error) {
+	sessionID, err := utils.RandomString(constant.RefreshTokenLength)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := i.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		_, err := i.sessionRepository.InsertOne(txCtx, entity.Session{
+			ID:        sessionID,
+			ActorType: i.actorType,
+			ActorID:   id,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return i.generate(txCtx, id, email, sessionID)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	token, ok := data.(*entity.JwtToken)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return token, nil
+}
+
+func (i *jwtTokenIssuer) rotate(ctx context.Context, refreshToken string) (*entity.JwtToken, error) {
+	rt, err := i.refreshTokenRepository.SelectOneByToken(ctx, i.actorType, utils.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.InvalidRefreshToken
+		}
+
+		return nil, err
+	}
+
+	if rt.RevokedAt != nil || rt.ExpiredAt.Before(time.Now()) {
+		return nil, apperror.InvalidRefreshToken
+	}
+
+	if rt.UsedAt != nil {
+		return nil, i.revokeReused(ctx, rt.Family)
+	}
+
+	data, err := i.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		if err := i.refreshTokenRepository.UpdateUsedByID(txCtx, rt.ID); err != nil {
+			return nil, err
+		}
+
+		return i.generate(txCtx, rt.ActorID, rt.ActorEmail, rt.Family)
+	})
+
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, i.revokeReused(ctx, rt.Family)
+		}
+
+		return nil, err
+	}
+
+	token, ok := data.(*entity.JwtToken)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return token, nil
+}
+
+func (i *jwtTokenIssuer) revoke(ctx context.Context, refreshToken string) error {
+	rt, err := i.refreshTokenRepository.SelectOneByToken(ctx, i.actorType, utils.HashToken(refreshToken))
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	return i.sessionRepository.RevokeByID(ctx, rt.Family)
+}
+
+func (i *jwtTokenIssuer) generate(ctx context.Context, id uint, email string, sessionID string) (*entity.JwtToken, error) {
+	jwtData := map[string]any{
+		"ID":    id,
+		"Email": email,
+	}
+
+	accessToken, err := i.jwtGenerate(jwtData, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := utils.RandomString(constant.RefreshTokenLength)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = i.refreshTokenRepository.InsertOne(ctx, entity.RefreshToken{
+		ActorType:  i.actorType,
+		ActorID:    id,
+		ActorEmail: email,
+		Token:      utils.HashToken(refreshToken),
+		Family:     sessionID,
+		ExpiredAt:  time.Now().Add(constant.RefreshTokenDuration),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.JwtToken{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func (i *jwtTokenIssuer) revokeReused(ctx context.Context, sessionID string) error {
+	if err := i.sessionRepository.RevokeByID(ctx, sessionID); err != nil {
+		return err
+	}
+
+	return apperror.InvalidRefreshToken
+}
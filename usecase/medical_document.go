@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type MedicalDocumentUsecase interface {
+	VerifyDocument(ctx context.Context, code string) (*entity.MedicalDocument, error)
+}
+
+type medicalDocumentUsecaseImpl struct {
+	medicalDocumentRepository repository.MedicalDocumentRepository
+}
+
+func NewMedicalDocumentUsecase(medicalDocumentRepository repository.MedicalDocumentRepository) *medicalDocumentUsecaseImpl {
+	return &medicalDocumentUsecaseImpl{
+		medicalDocumentRepository: medicalDocumentRepository,
+	}
+}
+
+func (u *medicalDocumentUsecaseImpl) VerifyDocument(ctx context.Context, code string) (*entity.MedicalDocument, error) {
+	document, err := u.medicalDocumentRepository.SelectOneByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	document.IsAuthentic = utils.VerifyDocumentSignature(document.Hash, document.Signature)
+	document.Status = medicalDocumentStatus(*document)
+
+	return document, nil
+}
+
+func medicalDocumentStatus(d entity.MedicalDocument) string {
+	if !d.IsAuthentic {
+		return constant.DocumentInvalidSignature
+	}
+
+	if d.SupersededAt != nil {
+		return constant.DocumentSuperseded
+	}
+
+	if d.ValidUntil != nil && time.Now().After(*d.ValidUntil) {
+		return constant.DocumentExpired
+	}
+
+	return constant.DocumentValid
+}
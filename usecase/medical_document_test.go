@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+)
+
+func TestMedicalDocumentStatus(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		document entity.MedicalDocument
+		want     string
+	}{
+		{name: "authentic document without expiry is valid", document: entity.MedicalDocument{IsAuthentic: true}, want: constant.DocumentValid},
+		{name: "authentic document before expiry is valid", document: entity.MedicalDocument{IsAuthentic: true, ValidUntil: &future}, want: constant.DocumentValid},
+		{name: "authentic document after expiry is expired", document: entity.MedicalDocument{IsAuthentic: true, ValidUntil: &past}, want: constant.DocumentExpired},
+		{name: "superseded document is superseded even before expiry", document: entity.MedicalDocument{IsAuthentic: true, ValidUntil: &future, SupersededAt: &past}, want: constant.DocumentSuperseded},
+		{name: "superseded takes precedence over expired", document: entity.MedicalDocument{IsAuthentic: true, ValidUntil: &past, SupersededAt: &past}, want: constant.DocumentSuperseded},
+		{name: "invalid signature takes precedence over everything", document: entity.MedicalDocument{ValidUntil: &past, SupersededAt: &past}, want: constant.DocumentInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := medicalDocumentStatus(tt.document)
+			if got != tt.want {
+				t.Errorf("medicalDocumentStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
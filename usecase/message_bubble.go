@@ -1,32 +1,184 @@
 package usecase
 
 import (
+	"context"
+	"errors"
+	"mime/multipart"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/libs/hub"
 	"Alice-Seahat-Healthcare/seahat-be/repository"
-	"context"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
 )
 
 type MessageBubbleUsecase interface {
 	AddMessageBubble(ctx context.Context, body entity.MessageBubble) (*entity.MessageBubble, error)
+	AddAttachment(ctx context.Context, body entity.MessageBubble, file multipart.File) (*entity.MessageBubble, error)
+	GetAllMessageBubbles(ctx context.Context, telemedicineID uint, cursor entity.Cursor) ([]entity.MessageBubble, error)
 }
 
 type messageBubbleUsecaseImpl struct {
-	messageBubbleRepository repository.MessageBubbleRepository
+	messageBubbleRepository     repository.MessageBubbleRepository
+	messageAttachmentRepository repository.MessageAttachmentRepository
+	telemedicineRepository      repository.TelemedicineRepository
+	transactor                  transaction.Transactor
+	hub                         hub.Hub
 }
 
 func NewMessageBubbleUsecase(
 	messageBubbleRepository repository.MessageBubbleRepository,
+	messageAttachmentRepository repository.MessageAttachmentRepository,
+	telemedicineRepository repository.TelemedicineRepository,
+	transactor transaction.Transactor,
+	hub hub.Hub,
 ) *messageBubbleUsecaseImpl {
 	return &messageBubbleUsecaseImpl{
-		messageBubbleRepository: messageBubbleRepository,
+		messageBubbleRepository:     messageBubbleRepository,
+		messageAttachmentRepository: messageAttachmentRepository,
+		telemedicineRepository:      telemedicineRepository,
+		transactor:                  transactor,
+		hub:                         hub,
 	}
 }
 
 func (u *messageBubbleUsecaseImpl) AddMessageBubble(ctx context.Context, body entity.MessageBubble) (*entity.MessageBubble, error) {
+	senderType, err := u.resolveSender(ctx, body.TelemedicineID)
+	if err != nil {
+		return nil, err
+	}
+
+	body.SenderType = senderType
 	messageBubble, err := u.messageBubbleRepository.InsertOne(ctx, body)
 	if err != nil {
 		return nil, err
 	}
 
+	u.publish(*messageBubble)
+
+	return messageBubble, nil
+}
+
+func (u *messageBubbleUsecaseImpl) AddAttachment(ctx context.Context, body entity.MessageBubble, file multipart.File) (*entity.MessageBubble, error) {
+	senderType, err := u.resolveSender(ctx, body.TelemedicineID)
+	if err != nil {
+		return nil, err
+	}
+
+	uploadUrl, err := utils.UploadCloudinary(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	body.SenderType = senderType
+	body.Payload = uploadUrl
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		messageBubble, err := u.messageBubbleRepository.InsertOne(txCtx, body)
+		if err != nil {
+			return nil, err
+		}
+
+		body.Attachment.MessageBubbleID = messageBubble.ID
+		attachment, err := u.messageAttachmentRepository.InsertOne(txCtx, *body.Attachment)
+		if err != nil {
+			return nil, err
+		}
+
+		messageBubble.Attachment = attachment
+		return messageBubble, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	messageBubble, ok := data.(*entity.MessageBubble)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	u.publish(*messageBubble)
+
 	return messageBubble, nil
 }
+
+func (u *messageBubbleUsecaseImpl) GetAllMessageBubbles(ctx context.Context, telemedicineID uint, cursor entity.Cursor) ([]entity.MessageBubble, error) {
+	user, _ := utils.CtxGetUser(ctx)
+	doctor, _ := utils.CtxGetDoctor(ctx)
+
+	var userID *uint
+	var doctorID *uint
+
+	if user != nil {
+		userID = &user.ID
+	} else {
+		doctorID = &doctor.ID
+	}
+
+	_, err := u.telemedicineRepository.SelectOneByID(ctx, telemedicineID, userID, doctorID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	return u.messageBubbleRepository.SelectAllByTelemedicineID(ctx, telemedicineID, cursor)
+}
+
+func (u *messageBubbleUsecaseImpl) resolveSender(ctx context.Context, telemedicineID uint) (string, error) {
+	user, _ := utils.CtxGetUser(ctx)
+	doctor, _ := utils.CtxGetDoctor(ctx)
+
+	var userID *uint
+	var doctorID *uint
+	var senderType string
+
+	if user != nil {
+		userID = &user.ID
+		senderType = constant.User
+	} else if doctor != nil {
+		doctorID = &doctor.ID
+		senderType = constant.Doctor
+	} else {
+		return "", apperror.ErrInternalServer
+	}
+
+	telemedicine, err := u.telemedicineRepository.SelectOneByID(ctx, telemedicineID, userID, doctorID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return "", apperror.ResourceNotFound
+		}
+
+		return "", err
+	}
+
+	if telemedicine.EndAt != nil {
+		return "", apperror.TelemedicineHasBeenEnded
+	}
+
+	if telemedicine.Status != constant.PaymentConfirmed {
+		return "", apperror.TelemedicineNotPaid
+	}
+
+	if telemedicine.StartAt != nil && telemedicine.StartAt.After(time.Now()) {
+		return "", apperror.TelemedicineNotStarted
+	}
+
+	if telemedicine.AdmittedAt == nil {
+		return "", apperror.TelemedicineQueued
+	}
+
+	return senderType, nil
+}
+
+func (u *messageBubbleUsecaseImpl) publish(messageBubble entity.MessageBubble) {
+	u.hub.Publish(hub.TelemedicineTopic(messageBubble.TelemedicineID), hub.Event{
+		Name: constant.EventMessage,
+		Data: messageBubble,
+	})
+}
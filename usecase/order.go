@@ -2,8 +2,10 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"math"
+	"time"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
 	"Alice-Seahat-Healthcare/seahat-be/constant"
@@ -17,26 +19,39 @@ import (
 )
 
 type OrderUsecase interface {
-	CreateOrder(ctx context.Context, orders []entity.Order) ([]entity.Order, error)
+	CreateOrder(ctx context.Context, orders []entity.Order) ([]entity.Order, []entity.DrugInteractionWarning, error)
 	UpdateConfirmOrder(ctx context.Context, body entity.Order) (*entity.Order, error)
 	OrderProceed(ctx context.Context, order entity.Order) (*entity.Order, error)
 	GetAllOrderByPharmacyManagerId(ctx context.Context) ([]*entity.Order, error)
 	OrderSent(ctx context.Context, order entity.Order) error
 	OrderCancelByPM(ctx context.Context, order entity.Order) error
+	DisputeOrder(ctx context.Context, order entity.Order) (*entity.Order, error)
+	ResolveDispute(ctx context.Context, order entity.Order, status string, reason string) (*entity.Order, error)
+	AutoConfirmOrders(ctx context.Context) error
+	GetOrderTimeline(ctx context.Context, orderId uint) ([]entity.OrderStatusHistory, error)
+	GetAllOrderByUserId(ctx context.Context, clc *entity.Collection) ([]*entity.Order, error)
+	GetOrderByUserId(ctx context.Context, orderId uint) (*entity.Order, error)
 }
 
 type orderUsecaseImpl struct {
-	orderRepository            repository.OrderRepository
-	orderDetailRepository      repository.OrderDetailRepository
-	paymentrepository          repository.PaymentRepository
-	pharmacyDrugrepository     repository.PharmacyDrugRepository
-	cartItemrepository         repository.CartItemRepository
-	transactor                 transaction.Transactor
-	stockJournalRepository     repository.StockJournalRepository
-	stockRequestRepository     repository.StockRequestRepository
-	stockRequestDrugRepository repository.StockRequestDrugRepository
-	shipmentMethodRepository   repository.ShipmentMethodRepository
-	addressRepository          repository.AddressRepository
+	orderRepository              repository.OrderRepository
+	orderDetailRepository        repository.OrderDetailRepository
+	paymentrepository            repository.PaymentRepository
+	pharmacyDrugrepository       repository.PharmacyDrugRepository
+	cartItemrepository           repository.CartItemRepository
+	transactor                   transaction.Transactor
+	stockJournalRepository       repository.StockJournalRepository
+	stockRequestRepository       repository.StockRequestRepository
+	stockRequestDrugRepository   repository.StockRequestDrugRepository
+	shipmentMethodRepository     repository.ShipmentMethodRepository
+	addressRepository            repository.AddressRepository
+	prescriptionRepository       repository.PrescriptionRepository
+	prescriptionFillRepository   repository.PrescriptionFillRepository
+	drugInteractionRepository    repository.DrugInteractionRepository
+	orderStatusHistoryRepository repository.OrderStatusHistoryRepository
+	stateMachine                 *orderStateMachine
+	stockReservation             *stockReservation
+	autoConfirmWindow            time.Duration
 }
 
 func NewOrderUsecase(
@@ -51,20 +66,31 @@ func NewOrderUsecase(
 	stockRequestDrugRepository repository.StockRequestDrugRepository,
 	shipmentMethodRepository repository.ShipmentMethodRepository,
 	addressRepository repository.AddressRepository,
-
+	prescriptionRepository repository.PrescriptionRepository,
+	prescriptionFillRepository repository.PrescriptionFillRepository,
+	drugInteractionRepository repository.DrugInteractionRepository,
+	orderStatusHistoryRepository repository.OrderStatusHistoryRepository,
+	autoConfirmWindow time.Duration,
 ) *orderUsecaseImpl {
 	return &orderUsecaseImpl{
-		orderRepository:            orderRepository,
-		transactor:                 transactor,
-		paymentrepository:          paymentrepository,
-		cartItemrepository:         cartItemrepository,
-		pharmacyDrugrepository:     pharmacyDrugrepository,
-		orderDetailRepository:      orderDetailRepository,
-		stockJournalRepository:     stockJournalRepository,
-		stockRequestRepository:     stockRequestRepository,
-		stockRequestDrugRepository: stockRequestDrugRepository,
-		shipmentMethodRepository:   shipmentMethodRepository,
-		addressRepository:          addressRepository,
+		orderRepository:              orderRepository,
+		transactor:                   transactor,
+		paymentrepository:            paymentrepository,
+		cartItemrepository:           cartItemrepository,
+		pharmacyDrugrepository:       pharmacyDrugrepository,
+		orderDetailRepository:        orderDetailRepository,
+		stockJournalRepository:       stockJournalRepository,
+		stockRequestRepository:       stockRequestRepository,
+		stockRequestDrugRepository:   stockRequestDrugRepository,
+		shipmentMethodRepository:     shipmentMethodRepository,
+		addressRepository:            addressRepository,
+		prescriptionRepository:       prescriptionRepository,
+		prescriptionFillRepository:   prescriptionFillRepository,
+		drugInteractionRepository:    drugInteractionRepository,
+		orderStatusHistoryRepository: orderStatusHistoryRepository,
+		stateMachine:                 newOrderStateMachine(orderStatusHistoryRepository),
+		stockReservation:             newStockReservation(orderDetailRepository, pharmacyDrugrepository, stockJournalRepository),
+		autoConfirmWindow:            autoConfirmWindow,
 	}
 }
 
@@ -77,48 +103,58 @@ func (u *orderUsecaseImpl) CreatePayment(ctx context.Context, payment *entity.Pa
 
 }
 
-func (u *orderUsecaseImpl) CreateOrder(ctx context.Context, orders []entity.Order) ([]entity.Order, error) {
+func (u *orderUsecaseImpl) CreateOrder(ctx context.Context, orders []entity.Order) ([]entity.Order, []entity.DrugInteractionWarning, error) {
 	userCtx, ok := utils.CtxGetUser(ctx)
 
 	if !ok {
-		return nil, apperror.ErrInternalServer
+		return nil, nil, apperror.ErrInternalServer
 	}
+	if err := u.stateMachine.check("", constant.WaitingForPayment, constant.User); err != nil {
+		return nil, nil, err
+	}
+
 	orders[0].Payment.UserId = userCtx.ID
+	var interactions []entity.DrugInteractionWarning
 	orderTransaction, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
-		orders, err := u.createOrderTransaction(ctx, orders, userCtx.ID)
+		orders, warnings, err := u.createOrderTransaction(txCtx, orders, userCtx.ID)
 		if err != nil {
 			return nil, err
 		}
+		interactions = warnings
 		return orders, err
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	orders = orderTransaction.([]entity.Order)
 
-	return orders, nil
+	return orders, interactions, nil
 }
-func (u *orderUsecaseImpl) createOrderTransaction(ctx context.Context, orders []entity.Order, userId uint) ([]entity.Order, error) {
+func (u *orderUsecaseImpl) createOrderTransaction(ctx context.Context, orders []entity.Order, userId uint) ([]entity.Order, []entity.DrugInteractionWarning, error) {
 	validOrders := make([]entity.Order, 0)
 	address, err := u.addressRepository.GetByID(ctx, orders[0].Payment.Address.ID, userId)
 	orders[0].Payment.FullUserAddress = address.Address
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	for index, order := range orders {
 		pharmacy, err := u.shipmentMethodRepository.GetPharmacySMethodByShipmentIdAndPharmacyID(ctx, order.PharmacyId, order.ShipmentMethod.ID)
 		if err != nil {
-			return nil, apperror.InvalidShipmentMethods
+			return nil, nil, apperror.InvalidShipmentMethods
 		}
 		if pharmacy == nil {
-			return nil, apperror.InvalidShipmentMethods
+			return nil, nil, apperror.InvalidShipmentMethods
 		}
 
 		cart, err := u.cartItemrepository.LockRow(ctx, order.Cart, userId, order.PharmacyId)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		if err := u.redeemPrescriptions(ctx, cart, userId); err != nil {
+			return nil, nil, err
 		}
 
 		orders[index].Cart = cart
@@ -136,17 +172,17 @@ func (u *orderUsecaseImpl) createOrderTransaction(ctx context.Context, orders []
 				payload := rajaongkir.CostPayload{Origin: pharmacy.Subdistrict.CityID, Destination: address.CityID, Weight: weight, Courier: pharmacy.ShipmentMethods[0].CourierName}
 				price, err := u.shipmentMethodRepository.GetThirdPartyShipmentPrice(ctx, payload, constant.EstimatedDeliveryTime)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				if price == 0 {
-					return nil, apperror.InvalidShipmentMethods
+					return nil, nil, apperror.InvalidShipmentMethods
 				}
 				shipmentPrice = uint(price)
 
 			} else {
 				distance, err := u.getDistanceKM(ctx, pharmacy.Location, address.Location)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				shipmentPrice = distance * *pharmacy.ShipmentMethods[0].Price
 			}
@@ -163,7 +199,19 @@ func (u *orderUsecaseImpl) createOrderTransaction(ctx context.Context, orders []
 	orders = validOrders
 
 	if len(orders) == 0 {
-		return nil, apperror.NoValidCartOrder
+		return nil, nil, apperror.NoValidCartOrder
+	}
+
+	drugIDs := make([]uint, 0)
+	for _, order := range orders {
+		for _, cartItem := range order.Cart {
+			drugIDs = append(drugIDs, cartItem.PharmacyDrug.Drug.ID)
+		}
+	}
+
+	interactions, err := checkDrugInteractions(ctx, u.drugInteractionRepository, drugIDs, nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	for _, order := range orders {
@@ -172,18 +220,39 @@ func (u *orderUsecaseImpl) createOrderTransaction(ctx context.Context, orders []
 
 	_, err = u.CreatePayment(ctx, orders[0].Payment)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	orders, err = u.orderRepository.InsertOrder(ctx, orders)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	orderIds := make([]uint, 0)
+	for _, order := range orders {
+		orderIds = append(orderIds, order.Id)
+	}
+
+	err = u.stateMachine.record(ctx, newOrderTransition("", constant.WaitingForPayment, constant.User, &userId), orderIds...)
+	if err != nil {
+		return nil, nil, err
 	}
 	for index, order := range orders {
 		order.Detail, err = u.orderDetailRepository.InsertOrderDetail(ctx, orders[index].Cart, order.Id)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		orders[index].Detail = order.Detail
+
+		err = u.stockReservation.reserve(ctx, order.Id, order.Detail)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = u.insertPrescriptionFills(ctx, order.Cart, order.Detail)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		cartItemIds := make([]uint, 0)
 		for _, cartItem := range order.Cart {
 			cartItemIds = append(cartItemIds, cartItem.ID)
@@ -191,11 +260,72 @@ func (u *orderUsecaseImpl) createOrderTransaction(ctx context.Context, orders []
 
 		err = u.cartItemrepository.DeleteManyByID(ctx, cartItemIds, userId)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
-	return orders, nil
+	return orders, interactions, nil
+}
+func (u *orderUsecaseImpl) redeemPrescriptions(ctx context.Context, cart []*entity.CartItem, userId uint) error {
+	for _, item := range cart {
+		if item.PrescriptionID == nil {
+			if item.PharmacyDrug.Drug.Classification == constant.HardDrugClassification {
+				return apperror.PrescriptionRequired
+			}
+
+			continue
+		}
+
+		prescription, err := u.prescriptionRepository.SelectOneForUpdateByID(ctx, *item.PrescriptionID, userId)
+		if err != nil {
+			if errors.Is(err, apperror.ErrResourceNotFound) {
+				return apperror.PrescriptionRequired
+			}
+
+			return err
+		}
+
+		if prescription.DrugID != item.PharmacyDrug.Drug.ID {
+			return apperror.PrescriptionRequired
+		}
+
+		if time.Now().After(prescription.ExpiredAt) {
+			return apperror.PrescriptionExpired
+		}
+
+		if prescription.RedeemedQuantity+item.Quantity > prescription.Quantity {
+			return apperror.PrescriptionRedeemed
+		}
+	}
+
+	return nil
+}
+
+func (u *orderUsecaseImpl) insertPrescriptionFills(ctx context.Context, cart []*entity.CartItem, details []*entity.OrderDetail) error {
+	fills := make([]entity.PrescriptionFill, 0)
+	for _, item := range cart {
+		if item.PrescriptionID == nil {
+			continue
+		}
+
+		for _, detail := range details {
+			if detail.PharmacyDrugId == item.PharmacyDrugID {
+				fills = append(fills, entity.PrescriptionFill{
+					PrescriptionID: *item.PrescriptionID,
+					OrderDetailID:  detail.Id,
+					Quantity:       item.Quantity,
+				})
+				break
+			}
+		}
+	}
+
+	if len(fills) == 0 {
+		return nil
+	}
+
+	return u.prescriptionFillRepository.InsertMany(ctx, fills)
 }
+
 func (u *orderUsecaseImpl) getDistanceKM(ctx context.Context, srcLoc, destLoc string) (uint, error) {
 	d, err := u.shipmentMethodRepository.GetDistanceKM(ctx, srcLoc, destLoc)
 	if err != nil {
@@ -214,9 +344,7 @@ func (u *orderUsecaseImpl) UpdateConfirmOrder(ctx context.Context, body entity.O
 		return nil, apperror.ErrInternalServer
 	}
 	userId := userCtx.ID
-	body.Status = constant.Sent
-	status := constant.OrderConfirmed
-	orders, err := u.orderRepository.UpdateOrderStatusByOrderId(ctx, body, status, userId)
+	recentStatus, err := u.orderRepository.SelecOrderStatusByOrderId(ctx, body.Id)
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
 			return nil, apperror.ResourceNotFound
@@ -224,7 +352,33 @@ func (u *orderUsecaseImpl) UpdateConfirmOrder(ctx context.Context, body entity.O
 
 		return nil, err
 	}
-	return orders, nil
+
+	body.Status = *recentStatus
+	status := constant.OrderConfirmed
+	if err := u.stateMachine.check(body.Status, status, constant.User); err != nil {
+		return nil, err
+	}
+
+	ordersTx, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		orders, err := u.orderRepository.UpdateOrderStatusByOrderId(txCtx, body, status, userId)
+		if err != nil {
+			if errors.Is(err, apperror.ErrResourceNotFound) {
+				return nil, apperror.ResourceNotFound
+			}
+
+			return nil, err
+		}
+
+		err = u.stateMachine.record(txCtx, newOrderTransition(*recentStatus, status, constant.User, &userId), orders.Id)
+		if err != nil {
+			return nil, err
+		}
+		return orders, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ordersTx.(*entity.Order), nil
 }
 
 func (u *orderUsecaseImpl) OrderProceed(ctx context.Context, order entity.Order) (*entity.Order, error) {
@@ -233,12 +387,16 @@ func (u *orderUsecaseImpl) OrderProceed(ctx context.Context, order entity.Order)
 		return nil, apperror.ErrInternalServer
 	}
 	managerId := managerCtx.ID
+	if err := u.stateMachine.check(constant.PaymentConfirmed, constant.Processed, constant.Manager); err != nil {
+		return nil, err
+	}
+
 	stockRequestDrug := make(map[uint][]*entity.StockRequestDrug)
 	soldStock := make(map[uint][]*entity.StockRequestDrug)
 	stockJournals := make([]entity.StockJurnal, 0)
 	order.Status = constant.PaymentConfirmed
 	orderTx, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
-		orderTx, err := u.orderProceedTx(ctx, &order, managerId, stockRequestDrug, soldStock, stockJournals)
+		orderTx, err := u.orderProceedTx(txCtx, &order, managerId, stockRequestDrug, soldStock, stockJournals)
 		if err != nil {
 			return nil, err
 		}
@@ -248,11 +406,23 @@ func (u *orderUsecaseImpl) OrderProceed(ctx context.Context, order entity.Order)
 		return nil, err
 	}
 	orderRes := orderTx.(*entity.Order)
+
+	fills, err := u.prescriptionFillRepository.SelectAllByOrderID(ctx, orderRes.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	orderRes.PrescriptionFills = fills
 	return orderRes, nil
 }
 
 func (u *orderUsecaseImpl) orderProceedTx(ctx context.Context, order *entity.Order, managerId uint, stockRequestDrug map[uint][]*entity.StockRequestDrug, soldStock map[uint][]*entity.StockRequestDrug, stockJournals []entity.StockJurnal) (*entity.Order, error) {
-	order, err := u.orderRepository.SelectOrdersByOrderId(ctx, *order)
+	err := u.stockReservation.release(ctx, order.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err = u.orderRepository.SelectOrdersByOrderId(ctx, *order)
 	if err != nil {
 		return nil, err
 	}
@@ -302,6 +472,11 @@ func (u *orderUsecaseImpl) orderProceedTx(ctx context.Context, order *entity.Ord
 	if err != nil {
 		return nil, err
 	}
+
+	err = u.stateMachine.record(ctx, newOrderTransition(constant.PaymentConfirmed, constant.Processed, constant.Manager, &managerId), order.Id)
+	if err != nil {
+		return nil, err
+	}
 	return order, nil
 }
 func (u *orderUsecaseImpl) stockRequesMutationAuto(ctx context.Context, pharmacyId uint, stockRequestDrug map[uint][]*entity.StockRequestDrug) error {
@@ -347,11 +522,20 @@ func (u *orderUsecaseImpl) OrderSent(ctx context.Context, order entity.Order) er
 	}
 	managerId := managerCtx.ID
 	order.Status = constant.Processed
-	_, err := u.orderRepository.PMUpdateOrderStatusByOrderId(ctx, order, constant.Sent, managerId)
-	if err != nil {
+	order.FinishedAt = &sql.NullTime{Time: time.Now().Add(u.autoConfirmWindow), Valid: true}
+	if err := u.stateMachine.check(order.Status, constant.Sent, constant.Manager); err != nil {
 		return err
 	}
-	return nil
+
+	_, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		sent, err := u.orderRepository.PMUpdateOrderStatusByOrderId(txCtx, order, constant.Sent, managerId)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, u.stateMachine.record(txCtx, newOrderTransition(constant.Processed, constant.Sent, constant.Manager, &managerId), sent.Id)
+	})
+	return err
 }
 
 func (u *orderUsecaseImpl) OrderCancelByPM(ctx context.Context, order entity.Order) error {
@@ -360,7 +544,7 @@ func (u *orderUsecaseImpl) OrderCancelByPM(ctx context.Context, order entity.Ord
 		return err
 	}
 
-	if *status != constant.Processed && *status != constant.PaymentConfirmed {
+	if err := u.stateMachine.check(*status, constant.Cancelled, constant.Manager); err != nil {
 		return apperror.CantCancelOrder
 	}
 	managerCtx, ok := utils.CtxGetManager(ctx)
@@ -369,23 +553,191 @@ func (u *orderUsecaseImpl) OrderCancelByPM(ctx context.Context, order entity.Ord
 	}
 	managerId := managerCtx.ID
 	order.Status = *status
-	if *status == constant.Processed {
-		orderDetails, err := u.orderDetailRepository.SelectOrderDetailByOrderId(ctx, order.Id)
+	_, err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		cancelled, err := u.orderRepository.PMUpdateOrderStatusByOrderId(txCtx, order, constant.Cancelled, managerId)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		stockJournals, err := u.pharmacyDrugrepository.UpdateReturnStock(ctx, orderDetails)
-		if err != nil {
-			return err
+
+		if *status == constant.PaymentConfirmed {
+			if err := u.stockReservation.release(txCtx, order.Id); err != nil {
+				return nil, err
+			}
 		}
-		err = u.stockJournalRepository.InsertStockJournal(ctx, stockJournals)
+		if *status == constant.Processed {
+			orderDetails, err := u.orderDetailRepository.SelectOrderDetailByOrderId(txCtx, order.Id)
+			if err != nil {
+				return nil, err
+			}
+			stockJournals, err := u.pharmacyDrugrepository.UpdateReturnStock(txCtx, orderDetails)
+			if err != nil {
+				return nil, err
+			}
+			err = u.stockJournalRepository.InsertStockJournal(txCtx, stockJournals)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, u.stateMachine.record(txCtx, newOrderTransition(*status, constant.Cancelled, constant.Manager, &managerId), cancelled.Id)
+	})
+	return err
+}
+
+func (u *orderUsecaseImpl) DisputeOrder(ctx context.Context, order entity.Order) (*entity.Order, error) {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	if err := u.stateMachine.check(constant.Sent, constant.Disputed, constant.User); err != nil {
+		return nil, err
+	}
+
+	disputedTx, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		disputed, err := u.orderRepository.UpdateDisputedByOrderId(txCtx, order, userCtx.ID)
 		if err != nil {
-			return err
+			if errors.Is(err, apperror.ErrResourceNotFound) {
+				return nil, apperror.CantDisputeOrder
+			}
+
+			return nil, err
+		}
+
+		transition := newOrderTransition(constant.Sent, constant.Disputed, constant.User, &userCtx.ID)
+		transition.Reason = disputed.DisputeReason
+
+		if err := u.stateMachine.record(txCtx, transition, disputed.Id); err != nil {
+			return nil, err
 		}
+
+		return disputed, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return disputedTx.(*entity.Order), nil
+}
+
+func (u *orderUsecaseImpl) ResolveDispute(ctx context.Context, order entity.Order, status string, reason string) (*entity.Order, error) {
+	var actor string
+	var actorId, managerId *uint
+	if managerCtx, ok := utils.CtxGetManager(ctx); ok {
+		actor = constant.Manager
+		actorId = &managerCtx.ID
+		managerId = &managerCtx.ID
+	} else if adminCtx, ok := utils.CtxGetAdmin(ctx); ok {
+		actor = constant.Admin
+		actorId = &adminCtx.ID
+	} else {
+		return nil, apperror.ErrInternalServer
+	}
+
+	if err := u.stateMachine.check(constant.Disputed, status, actor); err != nil {
+		return nil, err
 	}
-	_, err = u.orderRepository.PMUpdateOrderStatusByOrderId(ctx, order, constant.Cancelled, managerId)
+
+	resolvedTx, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		resolved, err := u.orderRepository.UpdateResolvedDisputeByOrderId(txCtx, order, status, managerId)
+		if err != nil {
+			if errors.Is(err, apperror.ErrResourceNotFound) {
+				return nil, apperror.CantResolveDispute
+			}
+
+			return nil, err
+		}
+
+		transition := newOrderTransition(constant.Disputed, status, actor, actorId)
+		transition.Reason = &reason
+
+		if err := u.stateMachine.record(txCtx, transition, resolved.Id); err != nil {
+			return nil, err
+		}
+
+		return resolved, nil
+	})
 	if err != nil {
+		return nil, err
+	}
+
+	return resolvedTx.(*entity.Order), nil
+}
+
+func (u *orderUsecaseImpl) AutoConfirmOrders(ctx context.Context) error {
+	if err := u.stateMachine.check(constant.Sent, constant.OrderConfirmed, constant.System); err != nil {
 		return err
 	}
-	return nil
+
+	_, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		orderIds, err := u.orderRepository.UpdateConfirmedBySentExpiry(txCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		reason := constant.OrderAutoConfirmedReason
+		transition := newOrderTransition(constant.Sent, constant.OrderConfirmed, constant.System, nil)
+		transition.Reason = &reason
+
+		return nil, u.stateMachine.record(txCtx, transition, orderIds...)
+	})
+
+	return err
+}
+
+func (u *orderUsecaseImpl) GetOrderTimeline(ctx context.Context, orderId uint) ([]entity.OrderStatusHistory, error) {
+	order, err := u.orderRepository.SelectOwnerByOrderId(ctx, orderId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	if userCtx, ok := utils.CtxGetUser(ctx); ok && userCtx.ID != order.Payment.UserId {
+		return nil, apperror.ResourceNotFound
+	}
+
+	if managerCtx, ok := utils.CtxGetManager(ctx); ok && managerCtx.ID != order.Pharmacy.ManagerID {
+		return nil, apperror.ResourceNotFound
+	}
+
+	return u.orderStatusHistoryRepository.SelectAllByOrderID(ctx, order.Id)
+}
+
+func (u *orderUsecaseImpl) GetAllOrderByUserId(ctx context.Context, clc *entity.Collection) ([]*entity.Order, error) {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	return u.orderRepository.GetAllOrderByUserId(ctx, userCtx.ID, clc)
+}
+
+func (u *orderUsecaseImpl) GetOrderByUserId(ctx context.Context, orderId uint) (*entity.Order, error) {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	order, err := u.orderRepository.SelectOneByIdAndUserId(ctx, orderId, userCtx.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	order.Detail, err = u.orderDetailRepository.SelectAllJoinDrugByOrderId(ctx, order.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	order.StatusHistories, err = u.orderStatusHistoryRepository.SelectAllByOrderID(ctx, order.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return order, nil
 }
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+)
+
+type orderTransition struct {
+	from   string
+	to     string
+	actors []string
+}
+
+var orderTransitions = []orderTransition{
+	{from: "", to: constant.WaitingForPayment, actors: []string{constant.User}},
+	{from: constant.WaitingForPayment, to: constant.WaitingForPaymentConfirmation, actors: []string{constant.User}},
+	{from: constant.WaitingForPayment, to: constant.Cancelled, actors: []string{constant.User, constant.Admin, constant.System}},
+	{from: constant.WaitingForPaymentConfirmation, to: constant.PaymentConfirmed, actors: []string{constant.Admin}},
+	{from: constant.WaitingForPaymentConfirmation, to: constant.WaitingForPayment, actors: []string{constant.Admin}},
+	{from: constant.PaymentConfirmed, to: constant.Processed, actors: []string{constant.Manager}},
+	{from: constant.PaymentConfirmed, to: constant.Cancelled, actors: []string{constant.Manager}},
+	{from: constant.Processed, to: constant.Sent, actors: []string{constant.Manager}},
+	{from: constant.Processed, to: constant.Cancelled, actors: []string{constant.Manager}},
+	{from: constant.Sent, to: constant.OrderConfirmed, actors: []string{constant.User, constant.System}},
+	{from: constant.Sent, to: constant.Disputed, actors: []string{constant.User}},
+	{from: constant.Disputed, to: constant.OrderConfirmed, actors: []string{constant.User, constant.Admin, constant.Manager}},
+	{from: constant.Disputed, to: constant.Cancelled, actors: []string{constant.Admin, constant.Manager}},
+}
+
+type orderStateMachine struct {
+	orderStatusHistoryRepository repository.OrderStatusHistoryRepository
+}
+
+func newOrderStateMachine(orderStatusHistoryRepository repository.OrderStatusHistoryRepository) *orderStateMachine {
+	return &orderStateMachine{
+		orderStatusHistoryRepository: orderStatusHistoryRepository,
+	}
+}
+
+func (m *orderStateMachine) check(from string, to string, actor string) error {
+	for _, transition := range orderTransitions {
+		if transition.from != from || transition.to != to {
+			continue
+		}
+
+		for _, allowed := range transition.actors {
+			if allowed == actor {
+				return nil
+			}
+		}
+	}
+
+	return apperror.InvalidOrderTransition
+}
+
+func (m *orderStateMachine) record(ctx context.Context, transition entity.OrderStatusHistory, orderIDs ...uint) error {
+	histories := make([]entity.OrderStatusHistory, 0)
+	for _, orderID := range orderIDs {
+		history := transition
+		history.OrderID = orderID
+		histories = append(histories, history)
+	}
+
+	return m.orderStatusHistoryRepository.InsertMany(ctx, histories)
+}
+
+func newOrderTransition(from string, to string, actor string, actorID *uint) entity.OrderStatusHistory {
+	transition := entity.OrderStatusHistory{
+		ToStatus:  to,
+		ActorRole: actor,
+		ActorID:   actorID,
+	}
+
+	if from != "" {
+		transition.FromStatus = &from
+	}
+
+	return transition
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+)
+
+func TestOrderStateMachineCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		actor   string
+		wantErr error
+	}{
+		{name: "user creates an order", from: "", to: constant.WaitingForPayment, actor: constant.User},
+		{name: "manager cannot create an order", from: "", to: constant.WaitingForPayment, actor: constant.Manager, wantErr: apperror.InvalidOrderTransition},
+		{name: "user uploads payment proof", from: constant.WaitingForPayment, to: constant.WaitingForPaymentConfirmation, actor: constant.User},
+		{name: "system cancels an unpaid order", from: constant.WaitingForPayment, to: constant.Cancelled, actor: constant.System},
+		{name: "manager cannot cancel an unpaid order", from: constant.WaitingForPayment, to: constant.Cancelled, actor: constant.Manager, wantErr: apperror.InvalidOrderTransition},
+		{name: "admin confirms a payment", from: constant.WaitingForPaymentConfirmation, to: constant.PaymentConfirmed, actor: constant.Admin},
+		{name: "user cannot confirm a payment", from: constant.WaitingForPaymentConfirmation, to: constant.PaymentConfirmed, actor: constant.User, wantErr: apperror.InvalidOrderTransition},
+		{name: "admin rejects a payment", from: constant.WaitingForPaymentConfirmation, to: constant.WaitingForPayment, actor: constant.Admin},
+		{name: "manager processes a paid order", from: constant.PaymentConfirmed, to: constant.Processed, actor: constant.Manager},
+		{name: "manager cannot skip processing", from: constant.PaymentConfirmed, to: constant.Sent, actor: constant.Manager, wantErr: apperror.InvalidOrderTransition},
+		{name: "manager cancels a processed order", from: constant.Processed, to: constant.Cancelled, actor: constant.Manager},
+		{name: "manager sends a processed order", from: constant.Processed, to: constant.Sent, actor: constant.Manager},
+		{name: "manager cannot cancel a sent order", from: constant.Sent, to: constant.Cancelled, actor: constant.Manager, wantErr: apperror.InvalidOrderTransition},
+		{name: "user confirms a sent order", from: constant.Sent, to: constant.OrderConfirmed, actor: constant.User},
+		{name: "system auto confirms a sent order", from: constant.Sent, to: constant.OrderConfirmed, actor: constant.System},
+		{name: "user disputes a sent order", from: constant.Sent, to: constant.Disputed, actor: constant.User},
+		{name: "system cannot dispute an order", from: constant.Sent, to: constant.Disputed, actor: constant.System, wantErr: apperror.InvalidOrderTransition},
+		{name: "user confirms a disputed order", from: constant.Disputed, to: constant.OrderConfirmed, actor: constant.User},
+		{name: "admin resolves a dispute by confirming", from: constant.Disputed, to: constant.OrderConfirmed, actor: constant.Admin},
+		{name: "manager resolves a dispute by cancelling", from: constant.Disputed, to: constant.Cancelled, actor: constant.Manager},
+		{name: "user cannot cancel a disputed order", from: constant.Disputed, to: constant.Cancelled, actor: constant.User, wantErr: apperror.InvalidOrderTransition},
+		{name: "system cannot auto confirm a disputed order", from: constant.Disputed, to: constant.OrderConfirmed, actor: constant.System, wantErr: apperror.InvalidOrderTransition},
+		{name: "confirmed order is final", from: constant.OrderConfirmed, to: constant.Disputed, actor: constant.User, wantErr: apperror.InvalidOrderTransition},
+		{name: "cancelled order is final", from: constant.Cancelled, to: constant.WaitingForPayment, actor: constant.Admin, wantErr: apperror.InvalidOrderTransition},
+	}
+
+	stateMachine := newOrderStateMachine(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := stateMachine.check(tt.from, tt.to, tt.actor)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("check(%q, %q, %q) = %v, want %v", tt.from, tt.to, tt.actor, err, tt.wantErr)
+			}
+		})
+	}
+}
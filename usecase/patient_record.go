@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+	"Alice-Seahat-Healthcare/seahat-be/utils"
+)
+
+type PatientRecordUsecase interface {
+	GetPatientRecord(ctx context.Context, access entity.PatientRecordAccessLog, clc *entity.Collection) (*entity.PatientRecord, error)
+}
+
+type patientRecordUsecaseImpl struct {
+	telemedicineRepository           repository.TelemedicineRepository
+	userRepository                   repository.UserRepository
+	prescriptionRepository           repository.PrescriptionRepository
+	prescriptionFillRepository       repository.PrescriptionFillRepository
+	clinicalNoteRepository           repository.ClinicalNoteRepository
+	patientRecordAccessLogRepository repository.PatientRecordAccessLogRepository
+	dependentRepository              repository.DependentRepository
+}
+
+func NewPatientRecordUsecase(
+	telemedicineRepository repository.TelemedicineRepository,
+	userRepository repository.UserRepository,
+	prescriptionRepository repository.PrescriptionRepository,
+	prescriptionFillRepository repository.PrescriptionFillRepository,
+	clinicalNoteRepository repository.ClinicalNoteRepository,
+	patientRecordAccessLogRepository repository.PatientRecordAccessLogRepository,
+	dependentRepository repository.DependentRepository,
+) *patientRecordUsecaseImpl {
+	return &patientRecordUsecaseImpl{
+		telemedicineRepository:           telemedicineRepository,
+		userRepository:                   userRepository,
+		prescriptionRepository:           prescriptionRepository,
+		prescriptionFillRepository:       prescriptionFillRepository,
+		clinicalNoteRepository:           clinicalNoteRepository,
+		patientRecordAccessLogRepository: patientRecordAccessLogRepository,
+		dependentRepository:              dependentRepository,
+	}
+}
+
+func (u *patientRecordUsecaseImpl) GetPatientRecord(ctx context.Context, access entity.PatientRecordAccessLog, clc *entity.Collection) (*entity.PatientRecord, error) {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	telemedicine, err := u.telemedicineRepository.SelectOneByID(ctx, access.TelemedicineID, nil, &doctor.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	isActive := telemedicine.EndAt == nil && telemedicine.AdmittedAt != nil && telemedicine.Status == constant.PaymentConfirmed
+	if !isActive {
+		return nil, apperror.PatientRecordUnavailable
+	}
+
+	access.DoctorID = doctor.ID
+	access.UserID = telemedicine.User.ID
+	if err := u.patientRecordAccessLogRepository.InsertOne(ctx, access); err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepository.SelectOneByID(ctx, telemedicine.User.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
+		return nil, err
+	}
+
+	var dependent *entity.Dependent
+	if telemedicine.DependentID != nil {
+		dependent, err = u.dependentRepository.SelectOneIncludeDeletedByID(ctx, *telemedicine.DependentID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	telemedicines, err := u.telemedicineRepository.SelectAllHistoryByPatient(ctx, user.ID, telemedicine.DependentID, clc)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range telemedicines {
+		prescriptions, err := u.prescriptionRepository.GetAllByTelemedicineID(ctx, telemedicines[index].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		fills, err := u.prescriptionFillRepository.SelectAllByTelemedicineID(ctx, telemedicines[index].ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range prescriptions {
+			for _, fill := range fills {
+				if fill.PrescriptionID == prescriptions[i].ID {
+					prescriptions[i].Fills = append(prescriptions[i].Fills, fill)
+				}
+			}
+		}
+
+		telemedicines[index].Prescriptions = prescriptions
+
+		note, err := u.clinicalNoteRepository.SelectOneByTelemedicineID(ctx, telemedicines[index].ID)
+		if err != nil && !errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, err
+		}
+
+		telemedicines[index].ClinicalNote = note
+	}
+
+	return &entity.PatientRecord{
+		User:          *user,
+		Dependent:     dependent,
+		Telemedicines: telemedicines,
+	}, nil
+}
@@ -22,24 +22,36 @@ type PaymentUsecase interface {
 	GetAllPaymentByUserId(ctx context.Context, clc *entity.Collection) ([]*entity.Payment, error)
 	AdminCancelPayment(ctx context.Context, body entity.Payment) ([]*entity.Order, error)
 	AdminRejectPayment(ctx context.Context, body entity.Payment) error
+	ExpireUnpaidPayments(ctx context.Context) error
 }
 
 type paymentUsecaseImpl struct {
-	paymentrepository repository.PaymentRepository
-	orderRepository   repository.OrderRepository
-	transactor        transaction.Transactor
+	paymentrepository      repository.PaymentRepository
+	orderRepository        repository.OrderRepository
+	telemedicineRepository repository.TelemedicineRepository
+	stateMachine           *orderStateMachine
+	stockReservation       *stockReservation
+	transactor             transaction.Transactor
 }
 
 func NewPaymentUsecase(
 	paymentrepository repository.PaymentRepository,
 	orderRepository repository.OrderRepository,
+	telemedicineRepository repository.TelemedicineRepository,
+	orderStatusHistoryRepository repository.OrderStatusHistoryRepository,
+	orderDetailRepository repository.OrderDetailRepository,
+	pharmacyDrugRepository repository.PharmacyDrugRepository,
+	stockJournalRepository repository.StockJournalRepository,
 	transactor transaction.Transactor,
 
 ) *paymentUsecaseImpl {
 	return &paymentUsecaseImpl{
-		paymentrepository: paymentrepository,
-		orderRepository:   orderRepository,
-		transactor:        transactor,
+		paymentrepository:      paymentrepository,
+		orderRepository:        orderRepository,
+		telemedicineRepository: telemedicineRepository,
+		stateMachine:           newOrderStateMachine(orderStatusHistoryRepository),
+		stockReservation:       newStockReservation(orderDetailRepository, pharmacyDrugRepository, stockJournalRepository),
+		transactor:             transactor,
 	}
 }
 
@@ -49,8 +61,14 @@ func (u *paymentUsecaseImpl) UpdatePaymentProof(ctx context.Context, body entity
 		return nil, apperror.ErrInternalServer
 	}
 	body.UserId = userCtx.ID
+	futureStatus := constant.WaitingForPaymentConfirmation
+	recentStatus := constant.WaitingForPayment
+	if err := u.stateMachine.check(recentStatus, futureStatus, constant.User); err != nil {
+		return nil, err
+	}
+
 	ordersTx, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
-		payment, err := u.paymentrepository.UpdatePaymentProof(ctx, body)
+		payment, err := u.paymentrepository.UpdatePaymentProof(txCtx, body)
 		if err != nil {
 			if errors.Is(err, apperror.ErrResourceNotFound) {
 				return nil, apperror.ResourceNotFound
@@ -58,9 +76,7 @@ func (u *paymentUsecaseImpl) UpdatePaymentProof(ctx context.Context, body entity
 
 			return nil, err
 		}
-		futureStatus := constant.WaitingForPaymentConfirmation
-		recentStatus := constant.WaitingForPayment
-		orders, err := u.orderRepository.UpdateOrderStatusByPaymentId(ctx, *payment, futureStatus, recentStatus)
+		orders, err := u.updateStatusByPaymentId(txCtx, *payment, futureStatus, recentStatus, constant.User, &userCtx.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -78,8 +94,14 @@ func (u *paymentUsecaseImpl) UserCancelPayment(ctx context.Context, body entity.
 		return nil, apperror.ErrInternalServer
 	}
 	body.UserId = userCtx.ID
+	futureStatus := constant.Cancelled
+	recentStatus := constant.WaitingForPayment
+	if err := u.stateMachine.check(recentStatus, futureStatus, constant.User); err != nil {
+		return nil, err
+	}
+
 	ordersTx, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
-		payment, err := u.paymentrepository.UserDeletePayment(ctx, body)
+		payment, err := u.paymentrepository.UserDeletePayment(txCtx, body)
 		if err != nil {
 			if errors.Is(err, apperror.ErrResourceNotFound) {
 				return nil, apperror.ResourceNotFound
@@ -87,9 +109,7 @@ func (u *paymentUsecaseImpl) UserCancelPayment(ctx context.Context, body entity.
 
 			return nil, err
 		}
-		futureStatus := constant.Cancelled
-		recentStatus := constant.WaitingForPayment
-		orders, err := u.orderRepository.UpdateOrderStatusByPaymentId(ctx, *payment, futureStatus, recentStatus)
+		orders, err := u.updateStatusByPaymentId(txCtx, *payment, futureStatus, recentStatus, constant.User, &userCtx.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -102,10 +122,18 @@ func (u *paymentUsecaseImpl) UserCancelPayment(ctx context.Context, body entity.
 	return orders, nil
 }
 func (u *paymentUsecaseImpl) AdminRejectPayment(ctx context.Context, body entity.Payment) error {
+	adminCtx, ok := utils.CtxGetAdmin(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+	futureStatus := constant.WaitingForPayment
+	recentStatus := constant.WaitingForPaymentConfirmation
+	if err := u.stateMachine.check(recentStatus, futureStatus, constant.Admin); err != nil {
+		return err
+	}
+
 	_, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
-		futureStatus := constant.WaitingForPayment
-		recentStatus := constant.WaitingForPaymentConfirmation
-		err := u.paymentrepository.UpdatePaymentExpiredAt(ctx, body.Id, futureStatus)
+		err := u.paymentrepository.UpdatePaymentExpiredAt(txCtx, body.Id, futureStatus)
 		if err != nil {
 			if errors.Is(err, apperror.ErrResourceNotFound) {
 				return nil, apperror.ResourceNotFound
@@ -113,7 +141,7 @@ func (u *paymentUsecaseImpl) AdminRejectPayment(ctx context.Context, body entity
 
 			return nil, err
 		}
-		orders, err := u.orderRepository.UpdateOrderStatusByPaymentId(ctx, body, futureStatus, recentStatus)
+		orders, err := u.updateStatusByPaymentId(txCtx, body, futureStatus, recentStatus, constant.Admin, &adminCtx.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -124,8 +152,18 @@ func (u *paymentUsecaseImpl) AdminRejectPayment(ctx context.Context, body entity
 }
 
 func (u *paymentUsecaseImpl) AdminCancelPayment(ctx context.Context, body entity.Payment) ([]*entity.Order, error) {
+	adminCtx, ok := utils.CtxGetAdmin(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+	futureStatus := constant.Cancelled
+	recentStatus := constant.WaitingForPayment
+	if err := u.stateMachine.check(recentStatus, futureStatus, constant.Admin); err != nil {
+		return nil, err
+	}
+
 	ordersTx, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
-		payment, err := u.paymentrepository.AdminDeletePayment(ctx, body)
+		payment, err := u.paymentrepository.AdminDeletePayment(txCtx, body)
 		if err != nil {
 			if errors.Is(err, apperror.ErrResourceNotFound) {
 				return nil, apperror.ResourceNotFound
@@ -133,9 +171,7 @@ func (u *paymentUsecaseImpl) AdminCancelPayment(ctx context.Context, body entity
 
 			return nil, err
 		}
-		futureStatus := constant.Cancelled
-		recentStatus := constant.WaitingForPayment
-		orders, err := u.orderRepository.UpdateOrderStatusByPaymentId(ctx, *payment, futureStatus, recentStatus)
+		orders, err := u.updateStatusByPaymentId(txCtx, *payment, futureStatus, recentStatus, constant.Admin, &adminCtx.ID)
 		if err != nil {
 			return nil, err
 		}
@@ -148,14 +184,22 @@ func (u *paymentUsecaseImpl) AdminCancelPayment(ctx context.Context, body entity
 	return orders, nil
 }
 func (u *paymentUsecaseImpl) PaymentConfirmation(ctx context.Context, body entity.Payment) ([]*entity.Order, error) {
+	adminCtx, ok := utils.CtxGetAdmin(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
 	futureStatus := constant.PaymentConfirmed
 	recentStatus := constant.WaitingForPaymentConfirmation
+	if err := u.stateMachine.check(recentStatus, futureStatus, constant.Admin); err != nil {
+		return nil, err
+	}
+
 	ordersTx, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
-		orders, err := u.orderRepository.UpdateOrderStatusByPaymentId(ctx, body, futureStatus, recentStatus)
+		orders, err := u.updateStatusByPaymentId(txCtx, body, futureStatus, recentStatus, constant.Admin, &adminCtx.ID)
 		if err != nil {
 			return nil, err
 		}
-		err = u.paymentrepository.UpdatePaymentExpiredAt(ctx, body.Id, futureStatus)
+		err = u.paymentrepository.UpdatePaymentExpiredAt(txCtx, body.Id, futureStatus)
 		if err != nil {
 			return nil, err
 		}
@@ -199,11 +243,49 @@ func (u *paymentUsecaseImpl) GetAllPaymentByUserId(ctx context.Context, clc *ent
 	return utils.HardPagination(payment, clc), nil
 }
 
+func (u *paymentUsecaseImpl) updateStatusByPaymentId(ctx context.Context, payment entity.Payment, futureStatus string, recentStatus string, actor string, actorId *uint) ([]*entity.Order, error) {
+	telemedicineAffected, err := u.telemedicineRepository.UpdateStatusByPaymentID(ctx, payment.Id, futureStatus, recentStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err := u.orderRepository.UpdateOrderStatusByPaymentId(ctx, payment, futureStatus, recentStatus)
+	if err != nil {
+		if errors.Is(err, apperror.NoValidOrderPayment) && telemedicineAffected > 0 {
+			return []*entity.Order{}, nil
+		}
+
+		return nil, err
+	}
+
+	orderIds := make([]uint, 0)
+	for _, order := range orders {
+		orderIds = append(orderIds, order.Id)
+	}
+
+	err = u.stateMachine.record(ctx, newOrderTransition(recentStatus, futureStatus, actor, actorId), orderIds...)
+	if err != nil {
+		return nil, err
+	}
+
+	if futureStatus == constant.Cancelled {
+		if err := u.stockReservation.release(ctx, orderIds...); err != nil {
+			return nil, err
+		}
+	}
+
+	return orders, nil
+}
+
 func (u *paymentUsecaseImpl) getPaymentStatus(payment *entity.Payment) *entity.Payment {
 	if payment.DeletedAt != nil {
 		payment.Status = constant.Cancelled
 		return payment
 	}
+	if payment.IsExpired {
+		payment.Status = constant.PaymentExpired
+		return payment
+	}
 	if payment.ExpiredAt != nil {
 		if payment.ExpiredAt.Time.After(time.Now()) && payment.Proof != nil {
 			payment.Status = constant.WaitingForPaymentConfirmation
@@ -241,3 +323,46 @@ func (u *paymentUsecaseImpl) sortingPaymentMapKey(payments map[uint]*entity.Paym
 	})
 	return keys
 }
+
+func (u *paymentUsecaseImpl) ExpireUnpaidPayments(ctx context.Context) error {
+	if err := u.stateMachine.check(constant.WaitingForPayment, constant.Cancelled, constant.System); err != nil {
+		return err
+	}
+
+	_, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		locked, err := u.paymentrepository.TryLockExpiry(txCtx)
+		if err != nil || !locked {
+			return nil, err
+		}
+
+		paymentIDs, err := u.paymentrepository.UpdateExpiredUnpaid(txCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := u.telemedicineRepository.UpdateCancelledByExpiredPayment(txCtx); err != nil {
+			return nil, err
+		}
+
+		if len(paymentIDs) == 0 {
+			return nil, nil
+		}
+
+		orderIds, err := u.orderRepository.UpdateCancelledByPaymentIDs(txCtx, paymentIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		reason := constant.PaymentExpiredReason
+		transition := newOrderTransition(constant.WaitingForPayment, constant.Cancelled, constant.System, nil)
+		transition.Reason = &reason
+
+		if err := u.stateMachine.record(txCtx, transition, orderIds...); err != nil {
+			return nil, err
+		}
+
+		return nil, u.stockReservation.release(txCtx, orderIds...)
+	})
+
+	return err
+}
@@ -182,6 +182,10 @@ func (u *pharmacyDrugUsecaseImpl) UpdatePharmacyDrug(ctx context.Context, pharma
 	}
 
 	quantity := int(pharmacyDrug.Stock - selectedPharmacyDrug.Stock)
+	err = u.pharmacyDrugRepository.UpdateOne(ctx, pharmacyDrug, id)
+	if err != nil {
+		return err
+	}
 	if quantity != 0 {
 		stockJournals := []entity.StockJurnal{{DrugId: pharmacyDrug.DrugID, PharmacyId: pharmacyDrug.PharmacyID, Description: constant.UpdatedStock, Quantity: quantity}}
 		err := u.stockJournalRepository.InsertStockJournal(ctx, stockJournals)
@@ -189,10 +193,6 @@ func (u *pharmacyDrugUsecaseImpl) UpdatePharmacyDrug(ctx context.Context, pharma
 			return err
 		}
 	}
-	err = u.pharmacyDrugRepository.UpdateOne(ctx, pharmacyDrug, id)
-	if err != nil {
-		return err
-	}
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
 	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 	"Alice-Seahat-Healthcare/seahat-be/repository"
@@ -12,53 +13,120 @@ import (
 )
 
 type PharmacyManagerUsecase interface {
-	Login(ctx context.Context, body entity.PharmacyManager) (string, error)
+	Login(ctx context.Context, body entity.PharmacyManager) (*entity.JwtToken, error)
+	Refresh(ctx context.Context, refreshToken string) (*entity.JwtToken, error)
+	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context) error
+	UpdatePassword(ctx context.Context, oldPwd string, newPwd string) error
 	GetProfile(ctx context.Context) (*entity.PharmacyManager, error)
 }
 
 type pharmacyManagerUsecaseImpl struct {
 	pharmacyManagerRepository repository.PharmacyManagerRepository
 	partnerRepository         repository.PartnerRepository
+	sessionRepository         repository.SessionRepository
 	transactor                transaction.Transactor
+	jwtTokenIssuer            *jwtTokenIssuer
 }
 
 func NewPharmacyManagerUsecase(
 	pharmacyManagerRepository repository.PharmacyManagerRepository,
 	partnerRepository repository.PartnerRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	sessionRepository repository.SessionRepository,
 	transactor transaction.Transactor,
 ) *pharmacyManagerUsecaseImpl {
 	return &pharmacyManagerUsecaseImpl{
 		pharmacyManagerRepository: pharmacyManagerRepository,
 		partnerRepository:         partnerRepository,
+		sessionRepository:         sessionRepository,
 		transactor:                transactor,
+		jwtTokenIssuer:            newJwtTokenIssuer(constant.Manager, utils.JwtGenerateManager, refreshTokenRepository, sessionRepository, transactor),
 	}
 }
 
-func (u *pharmacyManagerUsecaseImpl) Login(ctx context.Context, body entity.PharmacyManager) (string, error) {
+func (u *pharmacyManagerUsecaseImpl) Login(ctx context.Context, body entity.PharmacyManager) (*entity.JwtToken, error) {
 	pm, err := u.pharmacyManagerRepository.SelectOneByEmail(ctx, body.Email)
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
-			return "", apperror.InvalidCredential
+			return nil, apperror.InvalidCredential
 		}
 
-		return "", err
+		return nil, err
 	}
 
 	if !utils.HashCompareDefault(body.Password, &pm.Password) {
-		return "", apperror.InvalidCredential
+		return nil, apperror.InvalidCredential
+	}
+
+	return u.jwtTokenIssuer.issue(ctx, pm.ID, pm.Email)
+}
+
+func (u *pharmacyManagerUsecaseImpl) Refresh(ctx context.Context, refreshToken string) (*entity.JwtToken, error) {
+	return u.jwtTokenIssuer.rotate(ctx, refreshToken)
+}
+
+func (u *pharmacyManagerUsecaseImpl) Logout(ctx context.Context, refreshToken string) error {
+	sessionID := utils.CtxGetSessionID(ctx)
+	if sessionID == "" {
+		return apperror.ErrInternalServer
+	}
+
+	if err := u.sessionRepository.RevokeByID(ctx, sessionID); err != nil {
+		return err
 	}
 
-	jwtData := map[string]any{
-		"ID":    pm.ID,
-		"Email": pm.Email,
+	if refreshToken == "" {
+		return nil
+	}
+
+	return u.jwtTokenIssuer.revoke(ctx, refreshToken)
+}
+
+func (u *pharmacyManagerUsecaseImpl) LogoutAll(ctx context.Context) error {
+	actorCtx, ok := utils.CtxGetManager(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	return u.sessionRepository.RevokeByActor(ctx, constant.Manager, actorCtx.ID, "")
+}
+
+func (u *pharmacyManagerUsecaseImpl) UpdatePassword(ctx context.Context, oldPwd string, newPwd string) error {
+	actorCtx, ok := utils.CtxGetManager(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	pm, err := u.pharmacyManagerRepository.SelectOneByEmail(ctx, actorCtx.Email)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return apperror.ResourceNotFound
+		}
+
+		return err
+	}
+
+	if !utils.HashCompareDefault(oldPwd, &pm.Password) {
+		return apperror.InvalidPassword
+	}
+
+	hashPwd, err := utils.HashPasswordDefault(&newPwd)
+	if err != nil {
+		return err
+	}
+
+	err = u.pharmacyManagerRepository.UpdatePassword(ctx, pm.ID, *hashPwd)
+	if err != nil {
+		return err
 	}
 
-	token, err := utils.JwtGenerateManager(jwtData)
+	err = u.sessionRepository.RevokeByActor(ctx, constant.Manager, pm.ID, utils.CtxGetSessionID(ctx))
 	if err != nil {
-		return "", err
+		return err
 	}
 
-	return token, nil
+	return nil
 }
 
 func (u *pharmacyManagerUsecaseImpl) GetProfile(ctx context.Context) (*entity.PharmacyManager, error) {
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/apperror"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+)
+
+type slotFinder struct {
+	doctorScheduleRepository          repository.DoctorScheduleRepository
+	doctorScheduleExceptionRepository repository.DoctorScheduleExceptionRepository
+	appointmentRepository             repository.AppointmentRepository
+}
+
+func newSlotFinder(
+	doctorScheduleRepository repository.DoctorScheduleRepository,
+	doctorScheduleExceptionRepository repository.DoctorScheduleExceptionRepository,
+	appointmentRepository repository.AppointmentRepository,
+) *slotFinder {
+	return &slotFinder{
+		doctorScheduleRepository:          doctorScheduleRepository,
+		doctorScheduleExceptionRepository: doctorScheduleExceptionRepository,
+		appointmentRepository:             appointmentRepository,
+	}
+}
+
+func (f *slotFinder) find(ctx context.Context, doctorID uint, date time.Time) ([]entity.Slot, error) {
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	slots := make([]entity.Slot, 0)
+
+	exceptions, err := f.doctorScheduleExceptionRepository.SelectAllByDoctorIDAndDate(ctx, doctorID, date)
+	if err != nil {
+		return nil, err
+	}
+
+	blocked := make([]entity.Slot, 0)
+	for _, exception := range exceptions {
+		if exception.StartTime == nil || exception.EndTime == nil {
+			return slots, nil
+		}
+
+		blocked = append(blocked, entity.Slot{
+			StartAt: date.Add(clockOffset(*exception.StartTime)),
+			EndAt:   date.Add(clockOffset(*exception.EndTime)),
+		})
+	}
+
+	schedules, err := f.doctorScheduleRepository.SelectAllByDoctorIDAndDay(ctx, doctorID, int(date.Weekday()))
+	if err != nil {
+		return nil, err
+	}
+
+	appointments, err := f.appointmentRepository.SelectAllBookedByDoctorIDBetween(ctx, doctorID, date, date.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, appointment := range appointments {
+		blocked = append(blocked, entity.Slot{StartAt: appointment.StartAt, EndAt: appointment.EndAt})
+	}
+
+	now := time.Now()
+	for _, schedule := range schedules {
+		duration := time.Duration(schedule.SlotDuration) * time.Minute
+		end := date.Add(clockOffset(schedule.EndTime))
+
+		for start := date.Add(clockOffset(schedule.StartTime)); !start.Add(duration).After(end); start = start.Add(duration) {
+			slot := entity.Slot{StartAt: start, EndAt: start.Add(duration)}
+			if slot.StartAt.Before(now) || isSlotOverlap(slot, blocked) {
+				continue
+			}
+
+			slots = append(slots, slot)
+		}
+	}
+
+	return slots, nil
+}
+
+func clockOffset(clock string) time.Duration {
+	t, err := time.Parse(constant.TimeFormat, clock)
+	if err != nil {
+		return 0
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+}
+
+func isSlotOverlap(slot entity.Slot, others []entity.Slot) bool {
+	for _, other := range others {
+		if slot.StartAt.Before(other.EndAt) && other.StartAt.Before(slot.EndAt) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *slotFinder) findFree(ctx context.Context, doctorID uint, startAt time.Time) (*entity.Slot, error) {
+	slots, err := f.find(ctx, doctorID, startAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range slots {
+		if slots[i].StartAt.Equal(startAt) {
+			return &slots[i], nil
+		}
+	}
+
+	return nil, apperror.SlotUnavailable
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+
+	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/repository"
+)
+
+type stockReservation struct {
+	orderDetailRepository  repository.OrderDetailRepository
+	pharmacyDrugRepository repository.PharmacyDrugRepository
+	stockJournalRepository repository.StockJournalRepository
+}
+
+func newStockReservation(
+	orderDetailRepository repository.OrderDetailRepository,
+	pharmacyDrugRepository repository.PharmacyDrugRepository,
+	stockJournalRepository repository.StockJournalRepository,
+) *stockReservation {
+	return &stockReservation{
+		orderDetailRepository:  orderDetailRepository,
+		pharmacyDrugRepository: pharmacyDrugRepository,
+		stockJournalRepository: stockJournalRepository,
+	}
+}
+
+func (s *stockReservation) reserve(ctx context.Context, orderId uint, orderDetails []*entity.OrderDetail) error {
+	stockJournals, err := s.pharmacyDrugRepository.UpdateReserveStock(ctx, orderDetails)
+	if err != nil {
+		return err
+	}
+
+	if err := s.orderDetailRepository.UpdateStockReservedByOrderId(ctx, orderId); err != nil {
+		return err
+	}
+
+	return s.stockJournalRepository.InsertStockJournal(ctx, stockJournals)
+}
+
+func (s *stockReservation) release(ctx context.Context, orderIds ...uint) error {
+	if len(orderIds) == 0 {
+		return nil
+	}
+
+	orderDetails, err := s.orderDetailRepository.UpdateStockReleasedByOrderIds(ctx, orderIds)
+	if err != nil {
+		return err
+	}
+
+	stockJournals, err := s.pharmacyDrugRepository.UpdateReleaseReservedStock(ctx, orderDetails)
+	if err != nil {
+		return err
+	}
+
+	return s.stockJournalRepository.InsertStockJournal(ctx, stockJournals)
+}
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"io"
+	"strings"
 	"time"
 
 	"Alice-Seahat-Healthcare/seahat-be/apperror"
 	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/database/transaction"
 	"Alice-Seahat-Healthcare/seahat-be/entity"
+	"Alice-Seahat-Healthcare/seahat-be/libs/hub"
 	"Alice-Seahat-Healthcare/seahat-be/repository"
 	"Alice-Seahat-Healthcare/seahat-be/utils"
 )
@@ -18,15 +22,30 @@ type TelemedicineUsecase interface {
 	GetTelemedicineByID(ctx context.Context, id uint) (*entity.Telemedicine, error)
 	GetAllTelemedicine(ctx context.Context, clc *entity.Collection) ([]entity.Telemedicine, error)
 	UpdateOne(ctx context.Context, updateTelemedicine entity.Telemedicine) error
-	AddManyPrescriptedDrugs(ctx context.Context, prescriptions []entity.Prescription) (*string, error)
+	AddManyPrescriptedDrugs(ctx context.Context, prescriptions []entity.Prescription) (*string, *entity.PrescriptionWarnings, error)
 	UpdateOneAndCreateMedicalCertificate(ctx context.Context, updateTelemedicine entity.Telemedicine) (*string, error)
+	Subscribe(ctx context.Context, id uint) (<-chan hub.Event, func(), error)
+	GetQueue(ctx context.Context, id uint) (*entity.TelemedicineQueue, error)
+	CloseIdleSessions(ctx context.Context, idleTimeout time.Duration) error
+	SaveClinicalNote(ctx context.Context, note entity.ClinicalNote) (*entity.ClinicalNote, error)
 }
 
 type telemedicineUsecaseImpl struct {
-	telemedicineRepository repository.TelemedicineRepository
-	userRepository         repository.UserRepository
-	doctorRepository       repository.DoctorRepository
-	prescriptionRepository repository.PrescriptionRepository
+	telemedicineRepository     repository.TelemedicineRepository
+	userRepository             repository.UserRepository
+	doctorRepository           repository.DoctorRepository
+	prescriptionRepository     repository.PrescriptionRepository
+	prescriptionFillRepository repository.PrescriptionFillRepository
+	paymentRepository          repository.PaymentRepository
+	messageBubbleRepository    repository.MessageBubbleRepository
+	medicalDocumentRepository  repository.MedicalDocumentRepository
+	clinicalNoteRepository     repository.ClinicalNoteRepository
+	healthProfileRepository    repository.HealthProfileRepository
+	drugInteractionRepository  repository.DrugInteractionRepository
+	dependentRepository        repository.DependentRepository
+	transactor                 transaction.Transactor
+	hub                        hub.Hub
+	maxConcurrent              int
 }
 
 func NewTelemedicineUsecase(
@@ -34,12 +53,34 @@ func NewTelemedicineUsecase(
 	userRepository repository.UserRepository,
 	doctorRepository repository.DoctorRepository,
 	prescriptionRepository repository.PrescriptionRepository,
+	prescriptionFillRepository repository.PrescriptionFillRepository,
+	paymentRepository repository.PaymentRepository,
+	messageBubbleRepository repository.MessageBubbleRepository,
+	medicalDocumentRepository repository.MedicalDocumentRepository,
+	clinicalNoteRepository repository.ClinicalNoteRepository,
+	healthProfileRepository repository.HealthProfileRepository,
+	drugInteractionRepository repository.DrugInteractionRepository,
+	dependentRepository repository.DependentRepository,
+	transactor transaction.Transactor,
+	hub hub.Hub,
+	maxConcurrent int,
 ) *telemedicineUsecaseImpl {
 	return &telemedicineUsecaseImpl{
-		telemedicineRepository: telemedicineRepository,
-		userRepository:         userRepository,
-		doctorRepository:       doctorRepository,
-		prescriptionRepository: prescriptionRepository,
+		telemedicineRepository:     telemedicineRepository,
+		userRepository:             userRepository,
+		doctorRepository:           doctorRepository,
+		prescriptionRepository:     prescriptionRepository,
+		prescriptionFillRepository: prescriptionFillRepository,
+		paymentRepository:          paymentRepository,
+		messageBubbleRepository:    messageBubbleRepository,
+		medicalDocumentRepository:  medicalDocumentRepository,
+		clinicalNoteRepository:     clinicalNoteRepository,
+		healthProfileRepository:    healthProfileRepository,
+		drugInteractionRepository:  drugInteractionRepository,
+		dependentRepository:        dependentRepository,
+		transactor:                 transactor,
+		hub:                        hub,
+		maxConcurrent:              maxConcurrent,
 	}
 }
 
@@ -58,6 +99,17 @@ func (u *telemedicineUsecaseImpl) CreateTelemedicine(ctx context.Context, teleme
 		return nil, err
 	}
 
+	if telemedicine.DependentID != nil {
+		_, err := u.dependentRepository.SelectOneByID(ctx, *telemedicine.DependentID, userCtx.ID)
+		if err != nil {
+			if errors.Is(err, apperror.ErrResourceNotFound) {
+				return nil, apperror.DependentNotExist
+			}
+
+			return nil, err
+		}
+	}
+
 	_, err = u.telemedicineRepository.SelectOneOngoingByUserAndDoctorID(ctx, userCtx.ID, telemedicine.Doctor.ID)
 	if !errors.Is(err, apperror.ErrResourceNotFound) {
 		if err == nil {
@@ -69,11 +121,42 @@ func (u *telemedicineUsecaseImpl) CreateTelemedicine(ctx context.Context, teleme
 
 	telemedicine.User.ID = userCtx.ID
 	telemedicine.Price = int(doctor.Price)
-	telemedicineData, err := u.telemedicineRepository.InsertOne(ctx, telemedicine)
+	telemedicine.Status = constant.WaitingForPayment
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		payment, err := u.paymentRepository.InsertPayment(txCtx, &entity.Payment{
+			UserId:     userCtx.ID,
+			TotalPrice: telemedicine.Price,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		telemedicine.PaymentID = &payment.Id
+		return u.telemedicineRepository.InsertOne(txCtx, telemedicine)
+	})
+
 	if err != nil {
 		return nil, err
 	}
 
+	telemedicineData, ok := data.(*entity.Telemedicine)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	admittedIDs, err := u.admitQueued(ctx, telemedicineData.Doctor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range admittedIDs {
+		if id == telemedicineData.ID {
+			now := time.Now()
+			telemedicineData.AdmittedAt = &now
+		}
+	}
+
 	return telemedicineData, nil
 }
 
@@ -122,8 +205,41 @@ func (u *telemedicineUsecaseImpl) GetTelemedicineByID(ctx context.Context, id ui
 		return nil, err
 	}
 
+	fills, err := u.prescriptionFillRepository.SelectAllByTelemedicineID(ctx, telemedicineData.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range prescriptions {
+		for _, fill := range fills {
+			if fill.PrescriptionID == prescriptions[index].ID {
+				prescriptions[index].Fills = append(prescriptions[index].Fills, fill)
+			}
+		}
+	}
+
 	telemedicineData.Prescriptions = prescriptions
 
+	note, err := u.clinicalNoteRepository.SelectOneByTelemedicineID(ctx, telemedicineData.ID)
+	if err != nil && !errors.Is(err, apperror.ErrResourceNotFound) {
+		return nil, err
+	}
+
+	telemedicineData.ClinicalNote = note
+
+	if err := u.attachDependent(ctx, telemedicineData); err != nil {
+		return nil, err
+	}
+
+	if doctorID != nil && telemedicineData.DependentID == nil {
+		profile, err := u.healthProfileRepository.SelectOneByUserID(ctx, telemedicineData.User.ID)
+		if err != nil && !errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, err
+		}
+
+		telemedicineData.HealthProfile = profile
+	}
+
 	return telemedicineData, nil
 }
 
@@ -168,6 +284,10 @@ func (u *telemedicineUsecaseImpl) UpdateOne(ctx context.Context, updateTelemedic
 	}
 
 	updateTelemedicine.Doctor.ID = doctor.ID
+	updateTelemedicine.SessionStatus = constant.SessionConsulting
+	if updateTelemedicine.EndAt != nil && !updateTelemedicine.EndAt.IsZero() {
+		updateTelemedicine.SessionStatus = constant.SessionEnded
+	}
 
 	err := u.telemedicineRepository.UpdateOne(ctx, updateTelemedicine)
 	if err != nil {
@@ -178,6 +298,17 @@ func (u *telemedicineUsecaseImpl) UpdateOne(ctx context.Context, updateTelemedic
 		return err
 	}
 
+	if updateTelemedicine.EndAt != nil && !updateTelemedicine.EndAt.IsZero() {
+		u.hub.Publish(hub.TelemedicineTopic(updateTelemedicine.ID), hub.Event{
+			Name: constant.EventTelemedicineEnded,
+			Data: updateTelemedicine,
+		})
+	}
+
+	if _, err := u.admitQueued(ctx, doctor.ID); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -189,20 +320,10 @@ func (u *telemedicineUsecaseImpl) UpdateOneAndCreateMedicalCertificate(ctx conte
 
 	updateTelemedicine.Doctor.ID = doctor.ID
 
-	zeroduration := 0
-	if updateTelemedicine.RestDuration == &zeroduration {
+	if updateTelemedicine.RestDuration != nil && *updateTelemedicine.RestDuration == 0 {
 		updateTelemedicine.StartRestAt = &time.Time{}
 	}
 
-	if *updateTelemedicine.Diagnose == constant.DiagnoseStatus {
-		s := constant.EndChat
-		err := u.UpdateOne(ctx, updateTelemedicine)
-		if err != nil {
-			return nil, err
-		}
-		return &s, nil
-	}
-
 	telemedicine, err := u.telemedicineRepository.SelectOneByIdJoinDoctorUser(ctx, updateTelemedicine)
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
@@ -212,13 +333,39 @@ func (u *telemedicineUsecaseImpl) UpdateOneAndCreateMedicalCertificate(ctx conte
 		return nil, err
 	}
 	telemedicine.EndAt = updateTelemedicine.EndAt
-	pdf := bytes.NewBuffer(nil)
-	err = utils.GenerateMedicalCertificate(pdf, *telemedicine)
-	if err != nil {
+
+	if err := u.attachDependent(ctx, telemedicine); err != nil {
 		return nil, err
 	}
 
-	url, err := utils.UploadCloudinary(ctx, pdf)
+	var note *entity.ClinicalNote
+	if updateTelemedicine.ClinicalNote != nil {
+		note, err = u.saveClinicalNote(ctx, *updateTelemedicine.ClinicalNote)
+	} else {
+		note, err = u.clinicalNoteRepository.SelectOneByTelemedicineID(ctx, telemedicine.ID)
+	}
+	if err != nil && !errors.Is(err, apperror.ErrResourceNotFound) {
+		return nil, err
+	}
+
+	if note == nil || len(note.Diagnoses) == 0 {
+		s := constant.EndChat
+		err := u.UpdateOne(ctx, updateTelemedicine)
+		if err != nil {
+			return nil, err
+		}
+		return &s, nil
+	}
+
+	telemedicine.ClinicalNote = note
+
+	var validUntil *time.Time
+	if telemedicine.StartRestAt != nil && telemedicine.RestDuration != nil && *telemedicine.RestDuration > 0 {
+		restEndAt := telemedicine.StartRestAt.AddDate(0, 0, *telemedicine.RestDuration)
+		validUntil = &restEndAt
+	}
+
+	url, err := u.issueDocument(ctx, *telemedicine, constant.DocumentCertificate, validUntil, utils.GenerateMedicalCertificate)
 	if err != nil {
 		return nil, err
 	}
@@ -227,13 +374,19 @@ func (u *telemedicineUsecaseImpl) UpdateOneAndCreateMedicalCertificate(ctx conte
 	if err != nil {
 		return nil, err
 	}
+
+	u.hub.Publish(hub.TelemedicineTopic(telemedicine.ID), hub.Event{
+		Name: constant.EventCertificateReady,
+		Data: *telemedicine,
+	})
+
 	return &url, nil
 }
 
-func (u *telemedicineUsecaseImpl) AddManyPrescriptedDrugs(ctx context.Context, prescriptions []entity.Prescription) (*string, error) {
+func (u *telemedicineUsecaseImpl) AddManyPrescriptedDrugs(ctx context.Context, prescriptions []entity.Prescription) (*string, *entity.PrescriptionWarnings, error) {
 	doctor, ok := utils.CtxGetDoctor(ctx)
 	if !ok {
-		return nil, apperror.ErrInternalServer
+		return nil, nil, apperror.ErrInternalServer
 	}
 
 	telemedicineID := prescriptions[0].TelemedicineID
@@ -241,39 +394,277 @@ func (u *telemedicineUsecaseImpl) AddManyPrescriptedDrugs(ctx context.Context, p
 	telemedicine, err := u.telemedicineRepository.SelectOneByIdJoinDoctorUser(ctx, bodyTelemedicine)
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
-			return nil, apperror.ResourceNotFound
+			return nil, nil, apperror.ResourceNotFound
 		}
 
-		return nil, err
+		return nil, nil, err
 	}
 
 	if telemedicine.EndAt != nil {
-		return nil, apperror.TelemedicineHasBeenEnded
+		return nil, nil, apperror.TelemedicineHasBeenEnded
+	}
+
+	if err := u.attachDependent(ctx, telemedicine); err != nil {
+		return nil, nil, err
+	}
+
+	activeDrugIDs, err := u.prescriptionRepository.SelectAllActiveDrugIDsByPatient(ctx, telemedicine.User.ID, telemedicine.DependentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newDrugIDs := make([]uint, 0)
+	for _, prescription := range prescriptions {
+		newDrugIDs = append(newDrugIDs, prescription.DrugID)
+	}
+
+	interactions, err := checkDrugInteractions(ctx, u.drugInteractionRepository, newDrugIDs, activeDrugIDs)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	_, err = u.prescriptionRepository.InsertMany(ctx, prescriptions)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	prescriptions, err = u.prescriptionRepository.GetAllByTelemedicineID(ctx, telemedicine.ID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	telemedicine.Prescriptions = prescriptions
 
-	pdf := bytes.NewBuffer(nil)
-	err = utils.GeneratePrescription(pdf, *telemedicine)
+	allergies := make([]entity.Allergy, 0)
+	if telemedicine.DependentID == nil {
+		allergies, err = u.healthProfileRepository.SelectAllAllergiesByUserID(ctx, telemedicine.User.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	warnings := &entity.PrescriptionWarnings{
+		Allergies:    allergyWarnings(prescriptions, allergies),
+		Interactions: interactions,
+	}
+
+	var validUntil *time.Time
+	for index := range prescriptions {
+		if validUntil == nil || prescriptions[index].ExpiredAt.After(*validUntil) {
+			validUntil = &prescriptions[index].ExpiredAt
+		}
+	}
+
+	url, err := u.issueDocument(ctx, *telemedicine, constant.DocumentPrescription, validUntil, utils.GeneratePrescription)
+	if err != nil {
+		return nil, nil, err
+	}
+	telemedicine.PrescriptionUrl = &url
+	err = u.telemedicineRepository.UpdateOne(ctx, *telemedicine)
 	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, nil, apperror.ResourceNotFound
+		}
+
+		return nil, nil, err
+	}
+
+	u.hub.Publish(hub.TelemedicineTopic(telemedicine.ID), hub.Event{
+		Name: constant.EventPrescriptionReady,
+		Data: *telemedicine,
+	})
+
+	return &url, warnings, nil
+}
+
+func (u *telemedicineUsecaseImpl) attachDependent(ctx context.Context, telemedicine *entity.Telemedicine) error {
+	if telemedicine.DependentID == nil {
+		return nil
+	}
+
+	dependent, err := u.dependentRepository.SelectOneIncludeDeletedByID(ctx, *telemedicine.DependentID)
+	if err != nil {
+		return err
+	}
+
+	telemedicine.Dependent = dependent
+	return nil
+}
+
+func allergyWarnings(prescriptions []entity.Prescription, allergies []entity.Allergy) []entity.AllergyWarning {
+	warnings := make([]entity.AllergyWarning, 0)
+	for _, prescription := range prescriptions {
+		ingredients := strings.ToLower(strings.Join([]string{prescription.Drug.Composition, prescription.Drug.GenericName, prescription.Drug.Name}, " "))
+		for _, allergy := range allergies {
+			allergen := strings.ToLower(strings.TrimSpace(allergy.Allergen))
+			if allergen == "" || !strings.Contains(ingredients, allergen) {
+				continue
+			}
+
+			warnings = append(warnings, entity.AllergyWarning{
+				PrescriptionID: prescription.ID,
+				DrugID:         prescription.DrugID,
+				DrugName:       prescription.Drug.Name,
+				Allergen:       allergy.Allergen,
+				Severity:       allergy.Severity,
+			})
+		}
+	}
+
+	return warnings
+}
+
+func (u *telemedicineUsecaseImpl) SaveClinicalNote(ctx context.Context, note entity.ClinicalNote) (*entity.ClinicalNote, error) {
+	doctor, ok := utils.CtxGetDoctor(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	_, err := u.telemedicineRepository.SelectOneByID(ctx, note.TelemedicineID, nil, &doctor.ID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, apperror.ResourceNotFound
+		}
+
 		return nil, err
 	}
 
-	url, err := utils.UploadCloudinary(ctx, pdf)
+	return u.saveClinicalNote(ctx, note)
+}
+
+func (u *telemedicineUsecaseImpl) saveClinicalNote(ctx context.Context, note entity.ClinicalNote) (*entity.ClinicalNote, error) {
+	primaryCount := 0
+	for _, diagnosis := range note.Diagnoses {
+		if diagnosis.IsPrimary {
+			primaryCount++
+		}
+	}
+
+	if primaryCount == 0 && len(note.Diagnoses) > 0 {
+		note.Diagnoses[0].IsPrimary = true
+		primaryCount++
+	}
+
+	if primaryCount > 1 {
+		return nil, apperror.InvalidPrimaryDiagnosis
+	}
+
+	_, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		savedNote, err := u.clinicalNoteRepository.UpsertOne(txCtx, note)
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.clinicalNoteRepository.DeleteDiagnosesByNoteID(txCtx, savedNote.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(note.Diagnoses) == 0 {
+			return savedNote, nil
+		}
+
+		err = u.clinicalNoteRepository.InsertManyDiagnoses(txCtx, savedNote.ID, note.Diagnoses)
+		if err != nil {
+			if errors.Is(err, apperror.ErrICD10CodeNotFound) {
+				return nil, apperror.ICD10CodeNotFound
+			}
+
+			if errors.Is(err, apperror.ErrDuplicateDiagnosis) {
+				return nil, apperror.DuplicateDiagnosis
+			}
+
+			return nil, err
+		}
+
+		return savedNote, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	telemedicine.PrescriptionUrl = &url
-	err = u.telemedicineRepository.UpdateOne(ctx, *telemedicine)
+
+	return u.clinicalNoteRepository.SelectOneByTelemedicineID(ctx, note.TelemedicineID)
+}
+
+func (u *telemedicineUsecaseImpl) issueDocument(ctx context.Context, telemedicine entity.Telemedicine, documentType string, validUntil *time.Time, generate func(io.Writer, entity.Telemedicine, entity.MedicalDocument) error) (string, error) {
+	code, err := utils.GenerateDocumentCode()
+	if err != nil {
+		return "", err
+	}
+
+	document := entity.MedicalDocument{
+		Code:         code,
+		Type:         documentType,
+		Telemedicine: entity.Telemedicine{ID: telemedicine.ID},
+		IssuedAt:     time.Now(),
+		ValidUntil:   validUntil,
+	}
+
+	pdf := bytes.NewBuffer(nil)
+	err = generate(pdf, telemedicine, document)
+	if err != nil {
+		return "", err
+	}
+
+	document.Hash = utils.HashDocument(pdf.Bytes())
+	document.Signature = utils.SignDocument(document.Hash)
+
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		err := u.medicalDocumentRepository.UpdateSupersededByTelemedicineID(txCtx, telemedicine.ID, documentType)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := u.medicalDocumentRepository.InsertOne(txCtx, document); err != nil {
+			return nil, err
+		}
+
+		return utils.UploadCloudinary(txCtx, pdf)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	url, ok := data.(string)
+	if !ok {
+		return "", apperror.ErrInternalServer
+	}
+
+	return url, nil
+}
+
+func (u *telemedicineUsecaseImpl) Subscribe(ctx context.Context, id uint) (<-chan hub.Event, func(), error) {
+	user, _ := utils.CtxGetUser(ctx)
+	doctor, _ := utils.CtxGetDoctor(ctx)
+
+	var userID *uint
+	var doctorID *uint
+
+	if user != nil {
+		userID = &user.ID
+	} else {
+		doctorID = &doctor.ID
+	}
+
+	_, err := u.telemedicineRepository.SelectOneByID(ctx, id, userID, doctorID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrResourceNotFound) {
+			return nil, nil, apperror.ResourceNotFound
+		}
+
+		return nil, nil, err
+	}
+
+	events, unsubscribe := u.hub.Subscribe(hub.TelemedicineTopic(id))
+	return events, unsubscribe, nil
+}
+
+func (u *telemedicineUsecaseImpl) GetQueue(ctx context.Context, id uint) (*entity.TelemedicineQueue, error) {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	telemedicine, err := u.telemedicineRepository.SelectOneByID(ctx, id, &userCtx.ID, nil)
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
 			return nil, apperror.ResourceNotFound
@@ -281,5 +672,145 @@ func (u *telemedicineUsecaseImpl) AddManyPrescriptedDrugs(ctx context.Context, p
 
 		return nil, err
 	}
-	return &url, nil
+
+	if telemedicine.EndAt != nil {
+		return nil, apperror.TelemedicineHasBeenEnded
+	}
+
+	if telemedicine.Status != constant.PaymentConfirmed {
+		return nil, apperror.TelemedicineNotPaid
+	}
+
+	queue := &entity.TelemedicineQueue{
+		TelemedicineID: telemedicine.ID,
+		AdmittedAt:     telemedicine.AdmittedAt,
+	}
+
+	if telemedicine.AdmittedAt != nil {
+		return queue, nil
+	}
+
+	admittedIDs, err := u.admitQueued(ctx, telemedicine.Doctor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, admittedID := range admittedIDs {
+		if admittedID == telemedicine.ID {
+			now := time.Now()
+			queue.AdmittedAt = &now
+			return queue, nil
+		}
+	}
+
+	ahead, err := u.telemedicineRepository.CountQueuedBeforeID(ctx, telemedicine.Doctor.ID, telemedicine.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	averageDuration, err := u.telemedicineRepository.SelectAverageDurationByDoctorID(ctx, telemedicine.Doctor.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	queue.Position = ahead + 1
+	queue.EstimatedWait = estimateQueueWait(ahead, u.maxConcurrent, averageDuration)
+
+	return queue, nil
+}
+
+func estimateQueueWait(ahead int, maxConcurrent int, averageDuration *time.Duration) time.Duration {
+	consultationDuration := constant.DefaultConsultationDuration
+	if averageDuration != nil {
+		consultationDuration = *averageDuration
+	}
+
+	return time.Duration((ahead/maxConcurrent)+1) * consultationDuration
+}
+
+func (u *telemedicineUsecaseImpl) admitQueued(ctx context.Context, doctorID uint) ([]uint, error) {
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		if err := u.telemedicineRepository.LockQueueByDoctorID(txCtx, doctorID); err != nil {
+			return nil, err
+		}
+
+		return u.telemedicineRepository.UpdateAdmittedByDoctorID(txCtx, doctorID, u.maxConcurrent)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	admittedIDs, ok := data.([]uint)
+	if !ok {
+		return nil, apperror.ErrInternalServer
+	}
+
+	for _, id := range admittedIDs {
+		u.hub.Publish(hub.TelemedicineTopic(id), hub.Event{
+			Name: constant.EventTelemedicineAdmitted,
+			Data: entity.Telemedicine{ID: id, Doctor: entity.Doctor{ID: doctorID}},
+		})
+	}
+
+	return admittedIDs, nil
+}
+
+func (u *telemedicineUsecaseImpl) CloseIdleSessions(ctx context.Context, idleTimeout time.Duration) error {
+	messages := make(map[uint]entity.MessageBubble)
+	data, err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) (any, error) {
+		telemedicines, err := u.telemedicineRepository.UpdateEndedByIdle(txCtx, idleTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, telemedicine := range telemedicines {
+			message, err := u.messageBubbleRepository.InsertOne(txCtx, entity.MessageBubble{
+				TelemedicineID: telemedicine.ID,
+				SenderType:     constant.System,
+				Payload:        constant.IdleTelemedicineClosedMsg,
+				PayloadType:    constant.PayloadText,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			messages[telemedicine.ID] = *message
+		}
+
+		return telemedicines, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	telemedicines, ok := data.([]entity.Telemedicine)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	doctorIDs := make(map[uint]struct{})
+	for _, telemedicine := range telemedicines {
+		u.hub.Publish(hub.TelemedicineTopic(telemedicine.ID), hub.Event{
+			Name: constant.EventMessage,
+			Data: messages[telemedicine.ID],
+		})
+
+		u.hub.Publish(hub.TelemedicineTopic(telemedicine.ID), hub.Event{
+			Name: constant.EventTelemedicineEnded,
+			Data: telemedicine,
+		})
+
+		doctorIDs[telemedicine.Doctor.ID] = struct{}{}
+	}
+
+	for doctorID := range doctorIDs {
+		if _, err := u.admitQueued(ctx, doctorID); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
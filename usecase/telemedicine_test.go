@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+)
+
+func TestEstimateQueueWait(t *testing.T) {
+	averageDuration := 20 * time.Minute
+
+	tests := []struct {
+		name            string
+		ahead           int
+		maxConcurrent   int
+		averageDuration *time.Duration
+		want            time.Duration
+	}{
+		{name: "first in queue waits one default consultation", ahead: 0, maxConcurrent: 1, want: constant.DefaultConsultationDuration},
+		{name: "each patient ahead adds a consultation", ahead: 2, maxConcurrent: 1, want: 3 * constant.DefaultConsultationDuration},
+		{name: "doctor average replaces the default", ahead: 1, maxConcurrent: 1, averageDuration: &averageDuration, want: 2 * averageDuration},
+		{name: "patients ahead within one round share a consultation", ahead: 1, maxConcurrent: 2, averageDuration: &averageDuration, want: averageDuration},
+		{name: "full round adds a consultation", ahead: 2, maxConcurrent: 2, averageDuration: &averageDuration, want: 2 * averageDuration},
+		{name: "partial round rounds down", ahead: 5, maxConcurrent: 3, want: 2 * constant.DefaultConsultationDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := estimateQueueWait(tt.ahead, tt.maxConcurrent, tt.averageDuration)
+			if got != tt.want {
+				t.Errorf("estimateQueueWait(%d, %d) = %v, want %v", tt.ahead, tt.maxConcurrent, got, tt.want)
+			}
+		})
+	}
+}
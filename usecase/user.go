@@ -16,8 +16,11 @@ import (
 )
 
 type UserUsecase interface {
-	Login(ctx context.Context, user entity.User) (string, error)
-	LoginOAuth(ctx context.Context, googleToken string) (string, error)
+	Login(ctx context.Context, user entity.User) (*entity.JwtToken, error)
+	LoginOAuth(ctx context.Context, googleToken string) (*entity.JwtToken, error)
+	Refresh(ctx context.Context, refreshToken string) (*entity.JwtToken, error)
+	Logout(ctx context.Context, refreshToken string) error
+	LogoutAll(ctx context.Context) error
 	Register(ctx context.Context, body entity.User) (*entity.User, error)
 	RegisterOAuth(ctx context.Context, usr entity.User, googleToken string) (*entity.User, error)
 	CreateToken(ctx context.Context, user entity.User, tokenType string) (*entity.Token, error)
@@ -31,89 +34,105 @@ type UserUsecase interface {
 }
 
 type userUsecaseImpl struct {
-	userRepository   repository.UserRepository
-	doctorRepository repository.DoctorRepository
-	tokenRepository  repository.TokenRepository
-	transactor       transaction.Transactor
-	mail             mail.MailDialer
-	firebase         firebase.Firebase
+	userRepository    repository.UserRepository
+	doctorRepository  repository.DoctorRepository
+	tokenRepository   repository.TokenRepository
+	sessionRepository repository.SessionRepository
+	transactor        transaction.Transactor
+	mail              mail.MailDialer
+	firebase          firebase.Firebase
+	jwtTokenIssuer    *jwtTokenIssuer
 }
 
 func NewUserUsecase(
 	userRepository repository.UserRepository,
 	doctorRepository repository.DoctorRepository,
 	tokenRepository repository.TokenRepository,
+	refreshTokenRepository repository.RefreshTokenRepository,
+	sessionRepository repository.SessionRepository,
 	transactor transaction.Transactor,
 	mail mail.MailDialer,
 	firebase firebase.Firebase,
 ) *userUsecaseImpl {
 	return &userUsecaseImpl{
-		userRepository:   userRepository,
-		doctorRepository: doctorRepository,
-		tokenRepository:  tokenRepository,
-		transactor:       transactor,
-		mail:             mail,
-		firebase:         firebase,
+		userRepository:    userRepository,
+		doctorRepository:  doctorRepository,
+		tokenRepository:   tokenRepository,
+		sessionRepository: sessionRepository,
+		transactor:        transactor,
+		mail:              mail,
+		firebase:          firebase,
+		jwtTokenIssuer:    newJwtTokenIssuer(constant.User, utils.JwtGenerateUser, refreshTokenRepository, sessionRepository, transactor),
 	}
 }
 
-func (u *userUsecaseImpl) Login(ctx context.Context, body entity.User) (string, error) {
+func (u *userUsecaseImpl) Login(ctx context.Context, body entity.User) (*entity.JwtToken, error) {
 	user, err := u.userRepository.SelectOneByEmail(ctx, body.Email)
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
-			return "", apperror.InvalidCredential
+			return nil, apperror.InvalidCredential
 		}
 
-		return "", err
+		return nil, err
 	}
 
 	if !utils.HashCompareDefault(*body.Password, user.Password) {
-		return "", apperror.InvalidCredential
-	}
-
-	jwtData := map[string]any{
-		"ID":    user.ID,
-		"Email": user.Email,
+		return nil, apperror.InvalidCredential
 	}
 
-	token, err := utils.JwtGenerateUser(jwtData)
-	if err != nil {
-		return "", err
-	}
-
-	return token, nil
+	return u.jwtTokenIssuer.issue(ctx, user.ID, user.Email)
 }
 
-func (u *userUsecaseImpl) LoginOAuth(ctx context.Context, googleToken string) (string, error) {
+func (u *userUsecaseImpl) LoginOAuth(ctx context.Context, googleToken string) (*entity.JwtToken, error) {
 	googleUser, err := u.firebase.GetAuthIdentity(ctx, googleToken)
 	if err != nil {
-		return "", apperror.InvalidToken
+		return nil, apperror.InvalidToken
 	}
 
 	user, err := u.userRepository.SelectOneByEmail(ctx, googleUser["Email"])
 	if err != nil {
 		if errors.Is(err, apperror.ErrResourceNotFound) {
-			return "", apperror.EmailOAuthNotFound
+			return nil, apperror.EmailOAuthNotFound
 		}
 
-		return "", err
+		return nil, err
 	}
 
 	if !user.IsOAuth {
-		return "", apperror.InvalidToken
+		return nil, apperror.InvalidToken
 	}
 
-	jwtData := map[string]any{
-		"ID":    user.ID,
-		"Email": user.Email,
+	return u.jwtTokenIssuer.issue(ctx, user.ID, user.Email)
+}
+
+func (u *userUsecaseImpl) Refresh(ctx context.Context, refreshToken string) (*entity.JwtToken, error) {
+	return u.jwtTokenIssuer.rotate(ctx, refreshToken)
+}
+
+func (u *userUsecaseImpl) Logout(ctx context.Context, refreshToken string) error {
+	sessionID := utils.CtxGetSessionID(ctx)
+	if sessionID == "" {
+		return apperror.ErrInternalServer
 	}
 
-	token, err := utils.JwtGenerateUser(jwtData)
-	if err != nil {
-		return "", err
+	if err := u.sessionRepository.RevokeByID(ctx, sessionID); err != nil {
+		return err
 	}
 
-	return token, nil
+	if refreshToken == "" {
+		return nil
+	}
+
+	return u.jwtTokenIssuer.revoke(ctx, refreshToken)
+}
+
+func (u *userUsecaseImpl) LogoutAll(ctx context.Context) error {
+	userCtx, ok := utils.CtxGetUser(ctx)
+	if !ok {
+		return apperror.ErrInternalServer
+	}
+
+	return u.sessionRepository.RevokeByActor(ctx, constant.User, userCtx.ID, "")
 }
 
 func (u *userUsecaseImpl) Register(ctx context.Context, body entity.User) (*entity.User, error) {
@@ -277,10 +296,12 @@ func (u *userUsecaseImpl) ResetPassword(ctx context.Context, password string, to
 	}
 
 	var id = t.UserID
+	var actorType = constant.User
 	var repository repository.ActorRepository = u.userRepository
 
 	if t.UserID == nil {
 		id = t.DoctorID
+		actorType = constant.Doctor
 		repository = u.doctorRepository
 	}
 
@@ -297,6 +318,10 @@ func (u *userUsecaseImpl) ResetPassword(ctx context.Context, password string, to
 		return err
 	}
 
+	if err := u.sessionRepository.RevokeByActor(ctx, actorType, *id, ""); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -348,6 +373,11 @@ func (u *userUsecaseImpl) UpdatePassword(ctx context.Context, oldPwd string, new
 		return err
 	}
 
+	err = u.sessionRepository.RevokeByActor(ctx, constant.User, user.ID, utils.CtxGetSessionID(ctx))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
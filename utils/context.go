@@ -58,6 +58,16 @@ func CtxGetAdmin(ctx context.Context) (*entity.Admin, bool) {
 	}, true
 }
 
+func CtxGetSessionID(ctx context.Context) string {
+	act, ok := ctx.Value(constant.UserContext).(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	sessionID, _ := act["SessionID"].(string)
+	return sessionID
+}
+
 func getDetailActor(ctx context.Context, actor string) (map[string]any, bool) {
 	val := ctx.Value(constant.UserContext)
 
@@ -5,6 +5,7 @@ import (
 
 	"Alice-Seahat-Healthcare/seahat-be/config"
 	"Alice-Seahat-Healthcare/seahat-be/constant"
+	"Alice-Seahat-Healthcare/seahat-be/entity"
 
 	"github.com/gin-gonic/gin"
 )
@@ -18,10 +19,12 @@ func SetCookie(ctx *gin.Context, name string, value string, duration time.Durati
 	ctx.SetCookie(name, value, int(duration.Seconds()), "/", DomainURL(config.FE.URL), secure, false)
 }
 
-func SendLoginCookie(ctx *gin.Context, token string) {
-	SetCookie(ctx, constant.TokenAccess, token, constant.JwtDefaultDuration)
+func SendLoginCookie(ctx *gin.Context, token entity.JwtToken) {
+	SetCookie(ctx, constant.TokenAccess, token.AccessToken, constant.JwtDefaultDuration)
+	SetCookie(ctx, constant.TokenRefresh, token.RefreshToken, constant.RefreshTokenDuration)
 }
 
 func SendLogoutCookie(ctx *gin.Context) {
 	SetCookie(ctx, constant.TokenAccess, "", 0)
+	SetCookie(ctx, constant.TokenRefresh, "", 0)
 }
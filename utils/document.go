@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"Alice-Seahat-Healthcare/seahat-be/config"
+	"Alice-Seahat-Healthcare/seahat-be/constant"
+)
+
+func GenerateDocumentCode() (string, error) {
+	code, err := RandomString(constant.DocumentCodeLength)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(code), nil
+}
+
+func HashDocument(document []byte) string {
+	hash := sha256.Sum256(document)
+	return hex.EncodeToString(hash[:])
+}
+
+func SignDocument(hash string) string {
+	mac := hmac.New(sha256.New, []byte(config.Document.SigningKey))
+	mac.Write([]byte(hash))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func VerifyDocumentSignature(hash, signature string) bool {
+	return hmac.Equal([]byte(SignDocument(hash)), []byte(signature))
+}
+
+func DocumentVerifyURL(code string) string {
+	return fmt.Sprintf("%s/%s", strings.TrimRight(config.Document.VerifyURL, "/"), code)
+}
+
+func NameInitials(name string) string {
+	initials := make([]string, 0)
+	for _, word := range strings.Fields(name) {
+		initials = append(initials, strings.ToUpper(string([]rune(word)[:1]))+".")
+	}
+
+	return strings.Join(initials, " ")
+}
@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"Alice-Seahat-Healthcare/seahat-be/config"
 
 	"github.com/sirupsen/logrus"
@@ -48,3 +51,8 @@ func HashCompareDefault(pwd string, hashPwd *string) bool {
 
 	return HashComparePassword(pwd, []byte(password))
 }
+
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
@@ -14,6 +14,7 @@ import (
 )
 
 type JWTClaims struct {
+	ID       string
 	Data     any
 	Duration time.Duration
 }
@@ -21,6 +22,7 @@ type JWTClaims struct {
 func JwtGenerate(claims JWTClaims, secretKey string) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"data": claims.Data,
+		"jti":  claims.ID,
 		"iss":  constant.JwtIssuer,
 		"iat":  time.Now().Unix(),
 		"exp":  time.Now().Add(claims.Duration).Unix(),
@@ -55,8 +57,9 @@ func JwtParse(signed string, secretKey string) (jwt.MapClaims, bool) {
 	return nil, false
 }
 
-func JwtGenerateDefault(data any, secretKey string) (string, error) {
+func JwtGenerateDefault(data any, jti string, secretKey string) (string, error) {
 	claims := JWTClaims{
+		ID:       jti,
 		Data:     data,
 		Duration: constant.JwtDefaultDuration,
 	}
@@ -64,20 +67,20 @@ func JwtGenerateDefault(data any, secretKey string) (string, error) {
 	return JwtGenerate(claims, secretKey)
 }
 
-func JwtGenerateUser(data any) (string, error) {
-	return JwtGenerateDefault(data, config.Jwt.SecretKey)
+func JwtGenerateUser(data any, jti string) (string, error) {
+	return JwtGenerateDefault(data, jti, config.Jwt.SecretKey)
 }
 
-func JwtGenerateDoctor(data any) (string, error) {
-	return JwtGenerateDefault(data, config.Jwt.DoctorKey)
+func JwtGenerateDoctor(data any, jti string) (string, error) {
+	return JwtGenerateDefault(data, jti, config.Jwt.DoctorKey)
 }
 
-func JwtGenerateManager(data any) (string, error) {
-	return JwtGenerateDefault(data, config.Jwt.ManagerKey)
+func JwtGenerateManager(data any, jti string) (string, error) {
+	return JwtGenerateDefault(data, jti, config.Jwt.ManagerKey)
 }
 
-func JwtGenerateAdmin(data any) (string, error) {
-	return JwtGenerateDefault(data, config.Jwt.AdminKey)
+func JwtGenerateAdmin(data any, jti string) (string, error) {
+	return JwtGenerateDefault(data, jti, config.Jwt.AdminKey)
 }
 
 func JwtParseUser(signed string) (jwt.MapClaims, bool) {
@@ -3,30 +3,39 @@ package utils
 import (
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"Alice-Seahat-Healthcare/seahat-be/entity"
 
+	"github.com/boombuler/barcode/qr"
 	"github.com/phpdave11/gofpdf"
+	"github.com/phpdave11/gofpdf/contrib/barcode"
 	"github.com/phpdave11/gofpdf/contrib/gofpdi"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
 
-func GenerateMedicalCertificate(file io.Writer, telemedicine entity.Telemedicine) error {
+func GenerateMedicalCertificate(file io.Writer, telemedicine entity.Telemedicine, document entity.MedicalDocument) error {
 	caser := cases.Title(language.Und)
 	name := caser.String(telemedicine.User.Name)
-	dateOfBirth := telemedicine.User.DateOfBirth.Format("02 January 2006")
+	birthDate := telemedicine.User.DateOfBirth
 	gender := caser.String(telemedicine.User.Gender)
-	diagnose := *telemedicine.Diagnose
+	if telemedicine.Dependent != nil {
+		name = caser.String(telemedicine.Dependent.Name)
+		birthDate = telemedicine.Dependent.DateOfBirth
+		gender = caser.String(telemedicine.Dependent.Gender)
+	}
+	dateOfBirth := birthDate.Format("02 January 2006")
+	diagnose := diagnosisText(telemedicine.ClinicalNote)
 	restAt := telemedicine.StartRestAt.Local()
 	restAtFormated := restAt.Format("02 january 2006")
 	restDuration := *telemedicine.RestDuration
 	doctorname := caser.String(telemedicine.Doctor.Name)
 	doctorSpecialization := caser.String(telemedicine.Doctor.Specialization.Name)
 	restEndAt := restAt.Add(time.Duration(restDuration * 24 * int(time.Hour))).Format("02 january 2006")
-	yearAge := GetAge(telemedicine.User.DateOfBirth)
+	yearAge := GetAge(birthDate)
 
 	marginX := 13.6
 	marginY := 13.6
@@ -79,6 +88,8 @@ func GenerateMedicalCertificate(file io.Writer, telemedicine entity.Telemedicine
 		pdf.MultiCell(182, 4, doctorSpecialization, "", "R", false)
 	}
 
+	documentStamp(pdf, document, marginX, 116, 18)
+
 	err := pdf.Output(file)
 	if err != nil {
 		return err
@@ -100,7 +111,7 @@ func GetAge(dateOfBirth time.Time) int {
 	return yearAge
 }
 
-func GeneratePrescription(file io.Writer, telemedicine entity.Telemedicine) error {
+func GeneratePrescription(file io.Writer, telemedicine entity.Telemedicine, document entity.MedicalDocument) error {
 	marginX := 13.6
 	marginY := 26.1
 	pageNumber := 1
@@ -129,8 +140,33 @@ func GeneratePrescription(file io.Writer, telemedicine entity.Telemedicine) erro
 		pdf.SetFont("arial", "", 10)
 		pdf.MultiCell(210, 0, prescription.Notes, "", "L", false)
 
+		dosage := make([]string, 0)
+		if prescription.Frequency != nil {
+			dosage = append(dosage, *prescription.Frequency)
+		}
+		if prescription.DurationDays != nil {
+			dosage = append(dosage, fmt.Sprintf("%d days", *prescription.DurationDays))
+		}
+		if prescription.Route != nil {
+			dosage = append(dosage, *prescription.Route)
+		}
+		if len(dosage) > 0 {
+			pdf.SetXY(22, pdf.GetY()+4)
+			pdf.MultiCell(210, 0, strings.Join(dosage, " - "), "", "L", false)
+		}
+
 	}
 
+	if int(pdf.GetY()) >= 230 {
+		pdf.SetXY(0, 0)
+		pdf.AddPage()
+		pageNumber++
+		gofpdi.UseImportedTemplate(pdf, tpl1, 0, 0, 210, 297)
+		prescriptionHeader(pdf, pageNumber, telemedicine, timeNow)
+	}
+
+	documentStamp(pdf, document, 22, pdf.GetY()+12, 28)
+
 	err := pdf.Output(file)
 	if err != nil {
 		return err
@@ -163,7 +199,49 @@ func prescriptionHeader(pdf *gofpdf.Fpdf, pageNumber int, telemedicine entity.Te
 	pdf.MultiCell(210, 0, "Jika kondisi anda tidak membaik, silakan untuk mengunjungi fasilitas terdekat secepat mungkin", "", "C", false)
 	pdf.SetXY(0, pdf.GetY()+7)
 	pdf.SetFont("arial", "B", 11)
-	pdf.MultiCell(210, 0, fmt.Sprintf("Pasien: %s", caser.String(telemedicine.User.Name)), "", "C", false)
+	patientName := telemedicine.User.Name
+	if telemedicine.Dependent != nil {
+		patientName = telemedicine.Dependent.Name
+	}
+	pdf.MultiCell(210, 0, fmt.Sprintf("Pasien: %s", caser.String(patientName)), "", "C", false)
 	pdf.SetXY(0, pdf.GetY()+6)
 
 }
+
+func diagnosisText(note *entity.ClinicalNote) string {
+	if note == nil {
+		return "-"
+	}
+
+	diagnoses := make([]string, 0)
+	for _, diagnosis := range note.Diagnoses {
+		text := fmt.Sprintf("%s (%s)", diagnosis.ICD10Code.Description, diagnosis.ICD10Code.Code)
+		if diagnosis.IsPrimary {
+			diagnoses = append([]string{text}, diagnoses...)
+			continue
+		}
+
+		diagnoses = append(diagnoses, text)
+	}
+
+	if len(diagnoses) == 0 && note.Assessment != nil {
+		return *note.Assessment
+	}
+
+	if len(diagnoses) == 0 {
+		return "-"
+	}
+
+	return strings.Join(diagnoses, ", ")
+}
+
+func documentStamp(pdf *gofpdf.Fpdf, document entity.MedicalDocument, x, y, size float64) {
+	key := barcode.RegisterQR(pdf, DocumentVerifyURL(document.Code), qr.M, qr.Auto)
+	barcode.Barcode(pdf, key, x, y, size, size, false)
+
+	pdf.SetFont("arial", "", 8)
+	pdf.SetXY(x+size+3, y+size/2-3)
+	pdf.MultiCell(100, 0, fmt.Sprintf("No. Dokumen: %s", document.Code), "", "L", false)
+	pdf.SetXY(x+size+3, pdf.GetY()+4)
+	pdf.MultiCell(100, 0, "Pindai kode QR untuk memverifikasi keaslian dokumen", "", "L", false)
+}